@@ -1,7 +1,12 @@
 grammar Latex;
 
 latex
-    : note_title note_url note_created note_updated line_break NEWLINE* note_text EOF
+    : header line_break NEWLINE* note_text EOF
+    ;
+
+// the metadata lines of the note, in any order
+header
+    : (note_title | note_url | note_created | note_updated | note_metadata)+
     ;
 
 note_title
@@ -9,7 +14,7 @@ note_title
     ;
 
 note_url
-    : '\\textbf{URL:} \\url{' word+ '}' '\\\\' NEWLINE?
+    : '\\textbf{URL:}' WS* URL '\\\\' NEWLINE?
     ;
 
 note_created
@@ -20,12 +25,17 @@ note_updated
     : '\\textbf{Last Updated:}' WS* word+ '\\\\' NEWLINE?
     ;
 
+// any other metadata line, e.g. \textbf{Author:} value\\, whose value is read as note text is
+note_metadata
+    : METADATA_KEY WS* (tag | command | href | url | math | word)* '\\\\' NEWLINE?
+    ;
+
 note_text
     : (text | block | line_break | empty_line)* 
     ;
 
 text
-    : (tag | word)+ NEWLINE?
+    : (tag | footnote | command | href | url | math | word)+ NEWLINE?
     ;
 
 line_break
@@ -37,15 +47,41 @@ empty_line
     ;
 
 escaped_word
-    : '\\' content=(LETTER | SYMBOL)+ space=WS* NEWLINE?
+    : '\\' content=(LETTER | SYMBOL | AMPERSAND)+ space=WS* NEWLINE?
     ;
 
+// inline formatting commands, which may be nested and span several lines
 tag
+    : name=('\\textbf{' | '\\emph{' | '\\textit{' | '\\texttt{' | '\\underline{') (tag | footnote | command | href | url | math | word | NEWLINE)* '}'
+    ;
+
+// a footnote, whose text may hold inline formatting and span several lines as tags do
+footnote
+    : '\\footnote{' (tag | command | href | url | math | word | NEWLINE)* '}'
+    ;
+
+command
     : '\\' name=LETTER+ '{' word+ '}'
     ;
 
+// a link, whose text may hold inline formatting and span several lines as tags do
+href
+    : HREF '{' (tag | command | url | math | word | NEWLINE)* '}'
+    ;
+
+url
+    : URL
+    ;
+
+math
+    : INLINE_MATH     #inline_math
+    | DISPLAY_MATH    #display_math
+    ;
+
 word
     : escaped_word    #escaped
+    | DOLLAR          #dollar
+    | METADATA_KEY    #label
     | LETTER          #letter
     | PUNCTUATION     #punctuation
     | NUMBER          #number
@@ -62,18 +98,77 @@ verbatim_line
     : verbatim_content* NEWLINE
     ;
 
-block_line
-    : word+ NEWLINE+
-    ; 
-
+// the text of an item goes on until the next item or the end of the list, and may hold nested lists and quotes
 block_item
-    : '\\item' WS* block_line
+    : '\\item' note_text
     ;
 
 block
-    : '\\begin{itemize}' NEWLINE* block_item* '\\end{itemize}' NEWLINE?       #itemize
-    | '\\begin{enumerate}' NEWLINE* block_item* '\\end{enumerate}' NEWLINE?   #enumerate
-    | '\\begin{verbatim}' NEWLINE? verbatim_line* '\\end{verbatim}' NEWLINE?     #verbatim
+    : '\\begin{itemize}' (WS | NEWLINE)* block_item* '\\end{itemize}' WS* NEWLINE?              #itemize
+    | '\\begin{enumerate}' (WS | NEWLINE)* block_item* '\\end{enumerate}' WS* NEWLINE?          #enumerate
+    | '\\begin{quote}' note_text '\\end{quote}' WS* NEWLINE?                                     #quote
+    | '\\begin{quotation}' note_text '\\end{quotation}' WS* NEWLINE?                             #quotation
+    | '\\begin{verbatim}' NEWLINE? verbatim_line* '\\end{verbatim}' NEWLINE?                      #verbatim
+    | TABULAR (table_row '\\\\')* table_row '\\end{tabular}' WS* NEWLINE?                      #tabular
+    ;
+
+// rows of a table are separated by \\, and its cells by &. Rules, e.g. \hline, are left out
+table_row
+    : table_cell (AMPERSAND table_cell)*
+    ;
+
+table_cell
+    : (tag | footnote | command | href | url | math | word | TABLE_RULE | NEWLINE)*
+    ;
+
+// the key of a metadata line, e.g. \textbf{Author:}, which is bold text ending with a colon within the note text
+METADATA_KEY
+    : '\\textbf{' ~[{}:\r\n]+ ':}'
+    ;
+
+// the beginning of a table along with its column specification, e.g. {|l|c|r|}, which may hold braces, e.g. p{3cm}
+TABULAR
+    : '\\begin{tabular}' [ \t]* ('[' ~[\]\r\n]* ']')? [ \t]* '{' (~[{}\r\n] | '{' (~[{}\r\n] | '{' ~[{}\r\n]* '}')* '}')* '}'
+    ;
+
+TABLE_RULE
+    : '\\hline'
+    | '\\toprule'
+    | '\\midrule'
+    | '\\bottomrule'
+    | '\\cline{' ~[}\r\n]* '}'
+    ;
+
+// urls are read as written, as they may hold characters that latex requires escaping elsewhere, e.g. _ or %
+URL
+    : '\\url{' URL_CHARACTER* '}'
+    ;
+
+HREF
+    : '\\href{' URL_CHARACTER* '}'
+    ;
+
+fragment URL_CHARACTER
+    : ~[{}\r\n]
+    ;
+
+// math is read as written, as it may hold characters that latex requires escaping elsewhere, e.g. _ or ^. Inline
+// math is closed on its line, display math may span several lines
+INLINE_MATH
+    : '$' ('\\' ~[\r\n] | ~[$\\\r\n])+ '$'
+    | '\\(' ~[\r\n]*? '\\)'
+    ;
+
+DISPLAY_MATH
+    : '$$' .*? '$$'
+    | '\\[' .*? '\\]'
+    | '\\begin{equation}' .*? '\\end{equation}'
+    | '\\begin{equation*}' .*? '\\end{equation*}'
+    ;
+
+// an escaped dollar sign, which does not begin math
+DOLLAR
+    : '\\$'
     ;
 
 LETTER
@@ -104,11 +199,14 @@ PUNCTUATION
         )+
     ;
 
+AMPERSAND
+    : '&'
+    ;
+
 SYMBOL
     : '#'
     | '$'
     | '%'
-    | '&'
     | '_'
     | '^'
     | '>'
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"flag"
+	"log"
+	"os"
+	"cotonetes/utils"
+	"strconv"
+	"strings"
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage: %s [options] command
+
+Commands:
+  tree                       list the category tree with the number of notes of each category
+  rename <category> <name>   rename a category
+  move <category> <parent>   move a category and its sub-categories under another category ("" moves it to the top)
+  merge <source> <target>    move the notes and sub-categories of a category into another one, deleting the first
+  add-note <category> <id>   add a note, by the id shown by the search command, to a category
+  remove-note <category> <id>
+                             remove a note from a category, a note must keep at least one category
+
+Categories are written as paths, e.g. tools%sdocker
+
+`, os.Args[0], utils.Category_separator)
+	flag.PrintDefaults()
+}
+
+func main() {
+	db_path_ptr := flag.String("db", "cotonetes.db", "Path to database file")
+
+	flag.Usage = usage
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if  _, error := os.Stat(*db_path_ptr); error != nil {
+		log.Fatal(fmt.Sprintf("Provided database file does not exist!: %s", *db_path_ptr))
+	}
+
+	db_manager := utils.DatabaseManager{Db_path: *db_path_ptr}
+
+	db, err := db_manager.OpenDatabase()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db_manager.BeginTransaction(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	if err = db_manager.CheckSchemaVersion(tx); err != nil {
+		log.Fatal(err)
+	}
+
+	switch {
+	case args[0] == "tree" && len(args) == 1:
+		tree, err := db_manager.CategoryTree(tx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, node := range tree {
+			fmt.Printf("%s%s (%d notes, %d with sub-categories)\n", strings.Repeat("  ", node.Depth), node.Name, node.Note_count, node.Subtree_note_count)
+		}
+
+		return
+	case args[0] == "rename" && len(args) == 3:
+		err = db_manager.RenameCategory(tx, args[1], args[2])
+	case args[0] == "move" && len(args) == 3:
+		err = db_manager.MoveCategory(tx, args[1], args[2])
+	case args[0] == "merge" && len(args) == 3:
+		err = db_manager.MergeCategory(tx, args[1], args[2])
+	case (args[0] == "add-note" || args[0] == "remove-note") && len(args) == 3:
+		note_id, err := strconv.ParseInt(args[2], 10, 64)
+		if err != nil {
+			log.Fatalf("Invalid note id: %s", args[2])
+		}
+
+		if _, err = db_manager.GetNote(tx, note_id); err != nil {
+			log.Fatalf("Unable to find note %d: %v", note_id, err)
+		}
+
+		if args[0] == "add-note" {
+			err = db_manager.LinkNoteCategory(tx, note_id, args[1])
+		} else {
+			err = db_manager.UnlinkNoteCategory(tx, note_id, args[1])
+		}
+
+		if err != nil {
+			log.Fatal(err)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = db_manager.CommitTransaction(tx); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("Done")
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"flag"
+	"log"
+	"os"
+	"cotonetes/utils"
+	"strconv"
+	"strings"
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage: %s [options] command
+
+Commands:
+  list                           list the groups of notes saved from the same page, i.e. with the same canonical url
+  merge <target> <source>...     combine the text, categories and tags of the source notes into the target note,
+                                 deleting the source notes
+
+Notes are identified by the ids shown by list or by the search command
+
+`, os.Args[0])
+	flag.PrintDefaults()
+}
+
+func main() {
+	db_path_ptr := flag.String("db", "cotonetes.db", "Path to database file")
+
+	flag.Usage = usage
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if  _, error := os.Stat(*db_path_ptr); error != nil {
+		log.Fatal(fmt.Sprintf("Provided database file does not exist!: %s", *db_path_ptr))
+	}
+
+	db_manager := utils.DatabaseManager{Db_path: *db_path_ptr}
+
+	db, err := db_manager.OpenDatabase()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db_manager.BeginTransaction(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	if err = db_manager.CheckSchemaVersion(tx); err != nil {
+		log.Fatal(err)
+	}
+
+	switch {
+	case args[0] == "list" && len(args) == 1:
+		groups, err := db_manager.DuplicateNotes(tx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, group := range groups {
+			fmt.Println(group.Canonical_url)
+
+			for _, stored := range group.Notes {
+				categories, err := db_manager.NoteCategories(tx, stored.Id)
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				fmt.Printf("  [note %d] %s\n", stored.Id, stored.Note.Title)
+				fmt.Printf("    %s\n", stored.Note.Url)
+				fmt.Printf("    Categories: %s\n", strings.Join(categories, ", "))
+			}
+
+			fmt.Println()
+		}
+
+		fmt.Printf("%d groups of duplicate notes found\n", len(groups))
+	case args[0] == "merge" && len(args) >= 3:
+		note_ids := make([]int64, 0, len(args)-1)
+
+		for _, arg := range args[1:] {
+			note_id, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				log.Fatalf("Invalid note id: %s", arg)
+			}
+
+			note_ids = append(note_ids, note_id)
+		}
+
+		if err = db_manager.MergeNotes(tx, note_ids[0], note_ids[1:]); err != nil {
+			log.Fatal(err)
+		}
+
+		if err = db_manager.CommitTransaction(tx); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("%d notes merged into note %d\n", len(note_ids)-1, note_ids[0])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
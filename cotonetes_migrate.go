@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"flag"
+	"log"
+	"os"
+	"cotonetes/utils"
+)
+
+func main() {
+	db_path_ptr := flag.String("db", "cotonetes.db", "Path to database file")
+
+	flag.Parse()
+
+	if  _, error := os.Stat(*db_path_ptr); error != nil {
+		log.Fatal(fmt.Sprintf("Provided database file does not exist!: %s", *db_path_ptr))
+	}
+
+	db_manager := utils.DatabaseManager{Db_path: *db_path_ptr}
+
+	db, err := db_manager.OpenDatabase()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db_manager.BeginTransaction(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	from_version, to_version, err := db_manager.Migrate(tx)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Fatalf("%v: unable to rollback: %v\n", err, rollbackErr)
+		}
+		log.Fatal(err)
+	}
+
+	if err = db_manager.CommitTransaction(tx); err != nil {
+		log.Fatal(err)
+	}
+
+	if from_version == to_version {
+		fmt.Printf("Database %s is already at the latest schema version %d\n", *db_path_ptr, to_version)
+	} else {
+		fmt.Printf("Database %s migrated from schema version %d to %d\n", *db_path_ptr, from_version, to_version)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"flag"
+	"log"
+	"os"
+	"cotonetes/store"
+	"cotonetes/utils"
+	"strings"
+)
+
+func main() {
+	db_path_ptr := flag.String("db", "cotonetes.db", "Path to database file")
+	note_id_ptr := flag.Int64("note", 0, "Id of the note, as shown by the search command")
+	show_lines_ptr := flag.Bool("lines", false, "Also print the lines the note was imported from, as they are written now")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [options]\n\nShows the latex file and lines a note was last imported from, and whether they changed since\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if *note_id_ptr == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if  _, error := os.Stat(*db_path_ptr); error != nil {
+		log.Fatal(fmt.Sprintf("Provided database file does not exist!: %s", *db_path_ptr))
+	}
+
+	note_store, err := store.OpenSqliteStore(*db_path_ptr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer note_store.Close()
+
+	note, err := note_store.GetNote(*note_id_ptr)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%s [note %d]\n", note.Title, *note_id_ptr)
+
+	provenance := note.Provenance
+
+	// notes imported before provenance was recorded get it on their next import
+	if provenance.File_path == "" {
+		fmt.Println("   No provenance recorded, import the note again to record it")
+		return
+	}
+
+	fmt.Printf("   File: %s\n", provenance.File_path)
+	fmt.Printf("   Lines: %d-%d\n", provenance.Start_line, provenance.End_line)
+	fmt.Printf("   Hash: %s\n", provenance.Hash)
+
+	lines, err := utils.Provenance_lines(provenance)
+	if err != nil {
+		fmt.Printf("   Status: the lines can not be read: %v\n", err)
+		os.Exit(1)
+	}
+
+	is_changed := utils.Source_hash(lines) != provenance.Hash
+
+	if is_changed {
+		fmt.Println("   Status: changed since the import")
+	} else {
+		fmt.Println("   Status: unchanged since the import")
+	}
+
+	if *show_lines_ptr {
+		fmt.Printf("\n%s\n", strings.Join(lines, "\n"))
+	}
+
+	// a drifted note is reported through the exit status as well, e.g. for scripts
+	if is_changed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"flag"
+	"log"
+	"os"
+	"cotonetes/types"
+	"cotonetes/utils"
+	"database/sql"
+	"strconv"
+	"time"
+)
+
+const current_revision = "current"
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage: %s [options] command
+
+Commands:
+  list                    list the revisions of the note
+  diff <from> <to>        show the line differences between two revisions of the note
+  restore <revision>      replace the note contents with the given revision
+
+Revisions are identified by the ids shown by list, or "%s" for the current note contents
+
+`, os.Args[0], current_revision)
+	flag.PrintDefaults()
+}
+
+// get_revision returns the note contents at the given revision, which must belong to the note
+func get_revision(db_manager *utils.DatabaseManager, tx *sql.Tx, note_id int64, revision string) (types.Note, error) {
+	if revision == current_revision {
+		return db_manager.GetNote(tx, note_id)
+	}
+
+	revision_id, err := strconv.ParseInt(revision, 10, 64)
+	if err != nil {
+		return types.Note{}, fmt.Errorf("invalid revision: %s", revision)
+	}
+
+	rev, err := db_manager.GetRevision(tx, revision_id)
+	if err != nil {
+		return types.Note{}, err
+	}
+
+	if rev.Note_id != note_id {
+		return types.Note{}, fmt.Errorf("revision %d does not belong to note %d", revision_id, note_id)
+	}
+
+	return rev.Note, nil
+}
+
+func main() {
+	db_path_ptr := flag.String("db", "cotonetes.db", "Path to database file")
+	note_id_ptr := flag.Int64("note", 0, "Id of the note, as shown by the search command")
+	date_layout_ptr := flag.String("date-format", "2006-01-02 15:04:05", "Layout of the dates shown, written as the reference time Mon Jan 2 15:04:05 2006 in the desired format")
+
+	flag.Usage = usage
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	if *note_id_ptr == 0 || len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if  _, error := os.Stat(*db_path_ptr); error != nil {
+		log.Fatal(fmt.Sprintf("Provided database file does not exist!: %s", *db_path_ptr))
+	}
+
+	db_manager := utils.DatabaseManager{Db_path: *db_path_ptr}
+
+	db, err := db_manager.OpenDatabase()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db_manager.BeginTransaction(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	if err = db_manager.CheckSchemaVersion(tx); err != nil {
+		log.Fatal(err)
+	}
+
+	note, err := db_manager.GetNote(tx, *note_id_ptr)
+	if err != nil {
+		log.Fatalf("Unable to find note %d: %v", *note_id_ptr, err)
+	}
+
+	switch {
+	case args[0] == "list" && len(args) == 1:
+		revisions, err := db_manager.NoteRevisions(tx, *note_id_ptr)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, rev := range revisions {
+			fmt.Printf("%-8d replaced %s  %s\n", rev.Id, rev.Revised.In(time.Local).Format(*date_layout_ptr), rev.Note.Title)
+		}
+
+		fmt.Printf("%-8s updated  %s  %s\n", current_revision, note.Updated_date.Format(*date_layout_ptr), note.Title)
+	case args[0] == "diff" && len(args) == 3:
+		from_note, err := get_revision(&db_manager, tx, *note_id_ptr, args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		to_note, err := get_revision(&db_manager, tx, *note_id_ptr, args[2])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, line := range utils.Diff_lines(utils.Note_to_lines(from_note, *date_layout_ptr), utils.Note_to_lines(to_note, *date_layout_ptr)) {
+			fmt.Println(line)
+		}
+	case args[0] == "restore" && len(args) == 2:
+		revision_id, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			log.Fatalf("Invalid revision: %s", args[1])
+		}
+
+		if _, err = get_revision(&db_manager, tx, *note_id_ptr, args[1]); err != nil {
+			log.Fatal(err)
+		}
+
+		if err = db_manager.RestoreRevision(tx, revision_id); err != nil {
+			log.Fatal(err)
+		}
+
+		if err = db_manager.CommitTransaction(tx); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("Note %d restored to revision %d\n", *note_id_ptr, revision_id)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
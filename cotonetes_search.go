@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"flag"
+	"log"
+	"os"
+	"cotonetes/utils"
+	"strings"
+)
+
+func main() {
+	db_path_ptr := flag.String("db", "cotonetes.db", "Path to database file")
+	category_ptr := flag.String("category", "", "Only search notes in this category and its sub-categories")
+	tag_ptr := flag.String("tag", "", "Only search notes with this tag")
+	limit_ptr := flag.Int("limit", 20, "Maximum number of results")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [options] query\n\nThe query supports \"quoted phrases\" and prefix* terms\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	query := strings.Join(flag.Args(), " ")
+
+	if query == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if  _, error := os.Stat(*db_path_ptr); error != nil {
+		log.Fatal(fmt.Sprintf("Provided database file does not exist!: %s", *db_path_ptr))
+	}
+
+	db_manager := utils.DatabaseManager{Db_path: *db_path_ptr}
+
+	db, err := db_manager.OpenDatabase()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db_manager.BeginTransaction(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	if err = db_manager.CheckSchemaVersion(tx); err != nil {
+		log.Fatal(err)
+	}
+
+	results, err := db_manager.SearchNotes(tx, query, *category_ptr, *tag_ptr, *limit_ptr)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for i, result := range results {
+		fmt.Printf("%d. %s [note %d]\n", i+1, result.Title, result.Note_id)
+		fmt.Printf("   %s\n", result.Url)
+		fmt.Printf("   Categories: %s\n", strings.Join(result.Categories, ", "))
+		if len(result.Tags) > 0 {
+			fmt.Printf("   Tags: %s\n", strings.Join(result.Tags, ", "))
+		}
+		fmt.Printf("   %s\n\n", strings.ReplaceAll(result.Snippet, "\n", " "))
+	}
+
+	fmt.Printf("%d notes found\n", len(results))
+}
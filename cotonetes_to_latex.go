@@ -3,28 +3,34 @@ package main
 import (
 	"fmt"
 	"flag"
-	"database/sql"
-	_ "github.com/mattn/go-sqlite3"
 	"log"
 	"os"
 	"cotonetes/types"
 	"cotonetes/parser"
-	"strings"
-	"regexp"
+	"cotonetes/store"
 	"path/filepath"
 )
 
-type category struct {
-	Id int
-	Category string
-}
-
 func main() {
 	db_path_ptr := flag.String("db", "cotonetes.db", "Path to database file")
 	export_notes_path_ptr := flag.String("notes", "/tmp/export", "Path to folder to store exported notes in latex format")
+	tag_ptr := flag.String("tag", "", "Only export notes with this tag")
+	date_layout_ptr := flag.String("date-format", "2006-01-02", "Layout of the exported note dates, written as the reference time Mon Jan 2 15:04:05 2006 in the desired format")
+	ascii_ptr := flag.Bool("ascii", false, "Write accented letters and typographic characters as latex commands, e.g. \\'e and --, instead of unicode")
+	code_env_ptr := flag.String("code-env", "auto", "Latex environment of the code blocks with a language: lstlisting, minted, or auto for lstlisting when the listings package knows the language and minted otherwise")
 
 	flag.Parse()
 
+	encoding := parser.UnicodeText
+	if *ascii_ptr {
+		encoding = parser.AsciiText
+	}
+
+	code_environment, err := parser.Parse_code_environment(*code_env_ptr)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	if  _, error := os.Stat(*export_notes_path_ptr); error != nil {
 		log.Fatal(fmt.Sprintf("Provided note folder does not exist!: %s", *export_notes_path_ptr))
 	}
@@ -33,68 +39,52 @@ func main() {
 		log.Fatal(fmt.Sprintf("Provided database file does not exist!: %s", *db_path_ptr))
 	}
 
-	var err error
-	var db *sql.DB
-
-	if db, err = sql.Open("sqlite3", *db_path_ptr); err != nil {
+	note_store, err := store.OpenSqliteStore(*db_path_ptr)
+	if err != nil {
 		log.Fatal(err)
 	}
-	defer db.Close()
-
-	select_categories_stmt := `SELECT * FROM categories`
-	
-	select_notes_stmt := `SELECT notes.title, notes.url, notes.created, notes.last_updated, notes.note FROM notes INNER JOIN note_categories ON notes.id = note_categories.note_id WHERE note_categories.category_id = $1;`
+	defer note_store.Close()
 
-	var rows *sql.Rows
-
-	if rows, err = db.Query(select_categories_stmt); err != nil {
-		log.Fatalf("%q: %s\n", err, select_categories_stmt)
+	categories, err := note_store.ListCategories()
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	defer rows.Close()
+	failed_exports := 0
 
-	for rows.Next() {
-		var cat category
+	for _, cat := range categories {
+		category_folder_path := filepath.Join(*export_notes_path_ptr, filepath.FromSlash(cat.Path))
 
-		if err = rows.Scan(&cat.Id, &cat.Category); err != nil {
+		if err := os.MkdirAll(category_folder_path, 0755); err != nil {
 			log.Fatalf("%q\n", err)
 		}
 
-		category_folder_path := filepath.Join(*export_notes_path_ptr, cat.Category)
-
-		if err := os.MkdirAll(category_folder_path, 755); err != nil {
-			log.Fatalf("%q\n", err)
+		stored_notes, err := note_store.ListNotes(cat.Path, *tag_ptr)
+		if err != nil {
+			log.Fatal(err)
 		}
 
-		file_name_re := regexp.MustCompile(`^.*` + string(os.PathSeparator) + `(.*)$`)
-
-		file_name := file_name_re.ReplaceAllString(cat.Category, "$1")
-
-		sub_section_index := len(strings.Split(cat.Category, string(os.PathSeparator))) - 1
-
-		file_name_path := filepath.Join(category_folder_path, file_name + ".tex")
-
-		var cat_rows *sql.Rows
-		
-		if cat_rows, err = db.Query(select_notes_stmt, cat.Id); err != nil {
-			log.Fatalf("%q: %s\n", err, select_notes_stmt)
+		// categories holding only sub-categories only need their folder
+		if len(stored_notes) == 0 {
+			continue
 		}
 
-		note_list := make([]types.Note, 0)
-
-		for cat_rows.Next() {
-			var note types.Note
-			var text string
+		note_list := make([]types.Note, 0, len(stored_notes))
 
-			if err := cat_rows.Scan(&note.Title, &note.Url, &note.Created_date, &note.Updated_date, &text); err != nil {
-				log.Fatalf("%q\n", err)
-			}
+		for _, stored := range stored_notes {
+			note_list = append(note_list, stored.Note)
+		}
 
-			note.Text = strings.Split(text,"\n")
+		file_name_path := filepath.Join(category_folder_path, cat.Name + ".tex")
 
-			note_list = append(note_list, note)
+		// a category that fails to export does not prevent the other categories from being exported
+		if err := parser.Export_to_latex_file(file_name_path, cat.Path, note_list, *date_layout_ptr, encoding, code_environment); err != nil {
+			log.Printf("Failed to export category %s: %v\n", cat.Path, err)
+			failed_exports++
 		}
-		
-		parser.Export_to_latex_file(file_name_path, file_name, sub_section_index, note_list)
+	}
+
+	if failed_exports > 0 {
+		log.Fatalf("%d categories failed to export\n", failed_exports)
 	}
 }
@@ -26,6 +26,12 @@ func (s *BaseLatexListener) EnterLatex(ctx *LatexContext) {}
 // ExitLatex is called when production latex is exited.
 func (s *BaseLatexListener) ExitLatex(ctx *LatexContext) {}
 
+// EnterHeader is called when production header is entered.
+func (s *BaseLatexListener) EnterHeader(ctx *HeaderContext) {}
+
+// ExitHeader is called when production header is exited.
+func (s *BaseLatexListener) ExitHeader(ctx *HeaderContext) {}
+
 // EnterNote_title is called when production note_title is entered.
 func (s *BaseLatexListener) EnterNote_title(ctx *Note_titleContext) {}
 
@@ -50,6 +56,12 @@ func (s *BaseLatexListener) EnterNote_updated(ctx *Note_updatedContext) {}
 // ExitNote_updated is called when production note_updated is exited.
 func (s *BaseLatexListener) ExitNote_updated(ctx *Note_updatedContext) {}
 
+// EnterNote_metadata is called when production note_metadata is entered.
+func (s *BaseLatexListener) EnterNote_metadata(ctx *Note_metadataContext) {}
+
+// ExitNote_metadata is called when production note_metadata is exited.
+func (s *BaseLatexListener) ExitNote_metadata(ctx *Note_metadataContext) {}
+
 // EnterNote_text is called when production note_text is entered.
 func (s *BaseLatexListener) EnterNote_text(ctx *Note_textContext) {}
 
@@ -86,12 +98,60 @@ func (s *BaseLatexListener) EnterTag(ctx *TagContext) {}
 // ExitTag is called when production tag is exited.
 func (s *BaseLatexListener) ExitTag(ctx *TagContext) {}
 
+// EnterFootnote is called when production footnote is entered.
+func (s *BaseLatexListener) EnterFootnote(ctx *FootnoteContext) {}
+
+// ExitFootnote is called when production footnote is exited.
+func (s *BaseLatexListener) ExitFootnote(ctx *FootnoteContext) {}
+
+// EnterCommand is called when production command is entered.
+func (s *BaseLatexListener) EnterCommand(ctx *CommandContext) {}
+
+// ExitCommand is called when production command is exited.
+func (s *BaseLatexListener) ExitCommand(ctx *CommandContext) {}
+
+// EnterHref is called when production href is entered.
+func (s *BaseLatexListener) EnterHref(ctx *HrefContext) {}
+
+// ExitHref is called when production href is exited.
+func (s *BaseLatexListener) ExitHref(ctx *HrefContext) {}
+
+// EnterUrl is called when production url is entered.
+func (s *BaseLatexListener) EnterUrl(ctx *UrlContext) {}
+
+// ExitUrl is called when production url is exited.
+func (s *BaseLatexListener) ExitUrl(ctx *UrlContext) {}
+
+// EnterInline_math is called when production inline_math is entered.
+func (s *BaseLatexListener) EnterInline_math(ctx *Inline_mathContext) {}
+
+// ExitInline_math is called when production inline_math is exited.
+func (s *BaseLatexListener) ExitInline_math(ctx *Inline_mathContext) {}
+
+// EnterDisplay_math is called when production display_math is entered.
+func (s *BaseLatexListener) EnterDisplay_math(ctx *Display_mathContext) {}
+
+// ExitDisplay_math is called when production display_math is exited.
+func (s *BaseLatexListener) ExitDisplay_math(ctx *Display_mathContext) {}
+
 // EnterEscaped is called when production escaped is entered.
 func (s *BaseLatexListener) EnterEscaped(ctx *EscapedContext) {}
 
 // ExitEscaped is called when production escaped is exited.
 func (s *BaseLatexListener) ExitEscaped(ctx *EscapedContext) {}
 
+// EnterDollar is called when production dollar is entered.
+func (s *BaseLatexListener) EnterDollar(ctx *DollarContext) {}
+
+// ExitDollar is called when production dollar is exited.
+func (s *BaseLatexListener) ExitDollar(ctx *DollarContext) {}
+
+// EnterLabel is called when production label is entered.
+func (s *BaseLatexListener) EnterLabel(ctx *LabelContext) {}
+
+// ExitLabel is called when production label is exited.
+func (s *BaseLatexListener) ExitLabel(ctx *LabelContext) {}
+
 // EnterLetter is called when production letter is entered.
 func (s *BaseLatexListener) EnterLetter(ctx *LetterContext) {}
 
@@ -140,12 +200,6 @@ func (s *BaseLatexListener) EnterVerbatim_line(ctx *Verbatim_lineContext) {}
 // ExitVerbatim_line is called when production verbatim_line is exited.
 func (s *BaseLatexListener) ExitVerbatim_line(ctx *Verbatim_lineContext) {}
 
-// EnterBlock_line is called when production block_line is entered.
-func (s *BaseLatexListener) EnterBlock_line(ctx *Block_lineContext) {}
-
-// ExitBlock_line is called when production block_line is exited.
-func (s *BaseLatexListener) ExitBlock_line(ctx *Block_lineContext) {}
-
 // EnterBlock_item is called when production block_item is entered.
 func (s *BaseLatexListener) EnterBlock_item(ctx *Block_itemContext) {}
 
@@ -164,8 +218,38 @@ func (s *BaseLatexListener) EnterEnumerate(ctx *EnumerateContext) {}
 // ExitEnumerate is called when production enumerate is exited.
 func (s *BaseLatexListener) ExitEnumerate(ctx *EnumerateContext) {}
 
+// EnterQuote is called when production quote is entered.
+func (s *BaseLatexListener) EnterQuote(ctx *QuoteContext) {}
+
+// ExitQuote is called when production quote is exited.
+func (s *BaseLatexListener) ExitQuote(ctx *QuoteContext) {}
+
+// EnterQuotation is called when production quotation is entered.
+func (s *BaseLatexListener) EnterQuotation(ctx *QuotationContext) {}
+
+// ExitQuotation is called when production quotation is exited.
+func (s *BaseLatexListener) ExitQuotation(ctx *QuotationContext) {}
+
 // EnterVerbatim is called when production verbatim is entered.
 func (s *BaseLatexListener) EnterVerbatim(ctx *VerbatimContext) {}
 
 // ExitVerbatim is called when production verbatim is exited.
 func (s *BaseLatexListener) ExitVerbatim(ctx *VerbatimContext) {}
+
+// EnterTabular is called when production tabular is entered.
+func (s *BaseLatexListener) EnterTabular(ctx *TabularContext) {}
+
+// ExitTabular is called when production tabular is exited.
+func (s *BaseLatexListener) ExitTabular(ctx *TabularContext) {}
+
+// EnterTable_row is called when production table_row is entered.
+func (s *BaseLatexListener) EnterTable_row(ctx *Table_rowContext) {}
+
+// ExitTable_row is called when production table_row is exited.
+func (s *BaseLatexListener) ExitTable_row(ctx *Table_rowContext) {}
+
+// EnterTable_cell is called when production table_cell is entered.
+func (s *BaseLatexListener) EnterTable_cell(ctx *Table_cellContext) {}
+
+// ExitTable_cell is called when production table_cell is exited.
+func (s *BaseLatexListener) ExitTable_cell(ctx *Table_cellContext) {}
@@ -42,263 +42,479 @@ func latexlexerLexerInit() {
     "DEFAULT_MODE",
   }
   staticData.LiteralNames = []string{
-    "", "'\\textbf{Title:}'", "'\\\\'", "'\\textbf{URL:} \\url{'", "'}'", 
-    "'\\textbf{Created:}'", "'\\textbf{Last Updated:}'", "'\\'", "'{'", 
-    "'\\item'", "'\\begin{itemize}'", "'\\end{itemize}'", "'\\begin{enumerate}'", 
-    "'\\end{enumerate}'", "'\\begin{verbatim}'", "'\\end{verbatim}'", "", 
-    "", "", "", "'\\n'", "", "'\\r'",
+    "", "'\\textbf{Title:}'", "'\\\\'", "'\\textbf{URL:}'", "'\\textbf{Created:}'", 
+    "'\\textbf{Last Updated:}'", "'\\'", "'\\textbf{'", "'\\emph{'", "'\\textit{'", 
+    "'\\texttt{'", "'\\underline{'", "'}'", "'\\footnote{'", "'{'", "'\\item'", 
+    "'\\begin{itemize}'", "'\\end{itemize}'", "'\\begin{enumerate}'", "'\\end{enumerate}'", 
+    "'\\begin{quote}'", "'\\end{quote}'", "'\\begin{quotation}'", "'\\end{quotation}'", 
+    "'\\begin{verbatim}'", "'\\end{verbatim}'", "'\\end{tabular}'", "", 
+    "", "", "", "", "", "", "'\\$'", "", "", "'&'", "", "", "'\\n'", "", 
+    "'\\r'",
   }
   staticData.SymbolicNames = []string{
-    "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "LETTER", 
-    "PUNCTUATION", "SYMBOL", "NUMBER", "NEWLINE", "WS", "CR",
+    "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
+    "", "", "", "", "", "", "", "", "", "", "METADATA_KEY", "TABULAR", "TABLE_RULE", 
+    "URL", "HREF", "INLINE_MATH", "DISPLAY_MATH", "DOLLAR", "LETTER", "PUNCTUATION", 
+    "AMPERSAND", "SYMBOL", "NUMBER", "NEWLINE", "WS", "CR",
   }
   staticData.RuleNames = []string{
     "T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8", 
-    "T__9", "T__10", "T__11", "T__12", "T__13", "T__14", "LETTER", "PUNCTUATION", 
+    "T__9", "T__10", "T__11", "T__12", "T__13", "T__14", "T__15", "T__16", 
+    "T__17", "T__18", "T__19", "T__20", "T__21", "T__22", "T__23", "T__24", 
+    "T__25", "METADATA_KEY", "TABULAR", "TABLE_RULE", "URL", "HREF", "URL_CHARACTER", 
+    "INLINE_MATH", "DISPLAY_MATH", "DOLLAR", "LETTER", "PUNCTUATION", "AMPERSAND", 
     "SYMBOL", "NUMBER", "INT", "NEWLINE", "WS", "CR",
   }
   staticData.PredictionContextCache = antlr.NewPredictionContextCache()
   staticData.serializedATN = []int32{
-	4, 0, 22, 280, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 
+	4, 0, 42, 735, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 
 	4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 
 	10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 
 	7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 
-	20, 2, 21, 7, 21, 2, 22, 7, 22, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 
+	20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 
+	2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 
+	31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 
+	7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 
+	41, 2, 42, 7, 42, 2, 43, 7, 43, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 
 	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 
 	1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 
-	2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1, 
+	2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 
+	3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 
 	4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 
-	4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 
-	5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 
-	5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 
-	8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 
-	9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 
-	10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 
-	1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 
-	11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 
-	1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 
-	12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 
-	1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 
-	14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 
-	1, 14, 1, 15, 4, 15, 237, 8, 15, 11, 15, 12, 15, 238, 1, 16, 4, 16, 242, 
-	8, 16, 11, 16, 12, 16, 243, 1, 17, 1, 17, 1, 18, 3, 18, 249, 8, 18, 1, 
-	18, 1, 18, 1, 18, 4, 18, 254, 8, 18, 11, 18, 12, 18, 255, 3, 18, 258, 8, 
-	18, 1, 19, 1, 19, 1, 19, 5, 19, 263, 8, 19, 10, 19, 12, 19, 266, 9, 19, 
-	3, 19, 268, 8, 19, 1, 20, 1, 20, 1, 21, 4, 21, 273, 8, 21, 11, 21, 12, 
-	21, 274, 1, 22, 1, 22, 1, 22, 1, 22, 0, 0, 23, 1, 1, 3, 2, 5, 3, 7, 4, 
-	9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 
-	29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 0, 41, 20, 43, 21, 45, 22, 
-	1, 0, 6, 659, 0, 65, 90, 97, 122, 170, 170, 181, 181, 186, 186, 192, 214, 
-	216, 246, 248, 705, 710, 721, 736, 740, 748, 748, 750, 750, 880, 884, 886, 
-	887, 890, 893, 895, 895, 902, 902, 904, 906, 908, 908, 910, 929, 931, 1013, 
-	1015, 1153, 1162, 1327, 1329, 1366, 1369, 1369, 1376, 1416, 1488, 1514, 
-	1519, 1522, 1568, 1610, 1646, 1647, 1649, 1747, 1749, 1749, 1765, 1766, 
-	1774, 1775, 1786, 1788, 1791, 1791, 1808, 1808, 1810, 1839, 1869, 1957, 
-	1969, 1969, 1994, 2026, 2036, 2037, 2042, 2042, 2048, 2069, 2074, 2074, 
-	2084, 2084, 2088, 2088, 2112, 2136, 2144, 2154, 2160, 2183, 2185, 2190, 
-	2208, 2249, 2308, 2361, 2365, 2365, 2384, 2384, 2392, 2401, 2417, 2432, 
-	2437, 2444, 2447, 2448, 2451, 2472, 2474, 2480, 2482, 2482, 2486, 2489, 
-	2493, 2493, 2510, 2510, 2524, 2525, 2527, 2529, 2544, 2545, 2556, 2556, 
-	2565, 2570, 2575, 2576, 2579, 2600, 2602, 2608, 2610, 2611, 2613, 2614, 
-	2616, 2617, 2649, 2652, 2654, 2654, 2674, 2676, 2693, 2701, 2703, 2705, 
-	2707, 2728, 2730, 2736, 2738, 2739, 2741, 2745, 2749, 2749, 2768, 2768, 
-	2784, 2785, 2809, 2809, 2821, 2828, 2831, 2832, 2835, 2856, 2858, 2864, 
-	2866, 2867, 2869, 2873, 2877, 2877, 2908, 2909, 2911, 2913, 2929, 2929, 
-	2947, 2947, 2949, 2954, 2958, 2960, 2962, 2965, 2969, 2970, 2972, 2972, 
-	2974, 2975, 2979, 2980, 2984, 2986, 2990, 3001, 3024, 3024, 3077, 3084, 
-	3086, 3088, 3090, 3112, 3114, 3129, 3133, 3133, 3160, 3162, 3165, 3165, 
-	3168, 3169, 3200, 3200, 3205, 3212, 3214, 3216, 3218, 3240, 3242, 3251, 
-	3253, 3257, 3261, 3261, 3293, 3294, 3296, 3297, 3313, 3314, 3332, 3340, 
-	3342, 3344, 3346, 3386, 3389, 3389, 3406, 3406, 3412, 3414, 3423, 3425, 
-	3450, 3455, 3461, 3478, 3482, 3505, 3507, 3515, 3517, 3517, 3520, 3526, 
-	3585, 3632, 3634, 3635, 3648, 3654, 3713, 3714, 3716, 3716, 3718, 3722, 
-	3724, 3747, 3749, 3749, 3751, 3760, 3762, 3763, 3773, 3773, 3776, 3780, 
-	3782, 3782, 3804, 3807, 3840, 3840, 3904, 3911, 3913, 3948, 3976, 3980, 
-	4096, 4138, 4159, 4159, 4176, 4181, 4186, 4189, 4193, 4193, 4197, 4198, 
-	4206, 4208, 4213, 4225, 4238, 4238, 4256, 4293, 4295, 4295, 4301, 4301, 
-	4304, 4346, 4348, 4680, 4682, 4685, 4688, 4694, 4696, 4696, 4698, 4701, 
-	4704, 4744, 4746, 4749, 4752, 4784, 4786, 4789, 4792, 4798, 4800, 4800, 
-	4802, 4805, 4808, 4822, 4824, 4880, 4882, 4885, 4888, 4954, 4992, 5007, 
-	5024, 5109, 5112, 5117, 5121, 5740, 5743, 5759, 5761, 5786, 5792, 5866, 
-	5873, 5880, 5888, 5905, 5919, 5937, 5952, 5969, 5984, 5996, 5998, 6000, 
-	6016, 6067, 6103, 6103, 6108, 6108, 6176, 6264, 6272, 6276, 6279, 6312, 
-	6314, 6314, 6320, 6389, 6400, 6430, 6480, 6509, 6512, 6516, 6528, 6571, 
-	6576, 6601, 6656, 6678, 6688, 6740, 6823, 6823, 6917, 6963, 6981, 6988, 
-	7043, 7072, 7086, 7087, 7098, 7141, 7168, 7203, 7245, 7247, 7258, 7293, 
-	7296, 7304, 7312, 7354, 7357, 7359, 7401, 7404, 7406, 7411, 7413, 7414, 
-	7418, 7418, 7424, 7615, 7680, 7957, 7960, 7965, 7968, 8005, 8008, 8013, 
-	8016, 8023, 8025, 8025, 8027, 8027, 8029, 8029, 8031, 8061, 8064, 8116, 
-	8118, 8124, 8126, 8126, 8130, 8132, 8134, 8140, 8144, 8147, 8150, 8155, 
-	8160, 8172, 8178, 8180, 8182, 8188, 8305, 8305, 8319, 8319, 8336, 8348, 
-	8450, 8450, 8455, 8455, 8458, 8467, 8469, 8469, 8473, 8477, 8484, 8484, 
-	8486, 8486, 8488, 8488, 8490, 8493, 8495, 8505, 8508, 8511, 8517, 8521, 
-	8526, 8526, 8579, 8580, 11264, 11492, 11499, 11502, 11506, 11507, 11520, 
-	11557, 11559, 11559, 11565, 11565, 11568, 11623, 11631, 11631, 11648, 11670, 
-	11680, 11686, 11688, 11694, 11696, 11702, 11704, 11710, 11712, 11718, 11720, 
-	11726, 11728, 11734, 11736, 11742, 11823, 11823, 12293, 12294, 12337, 12341, 
-	12347, 12348, 12353, 12438, 12445, 12447, 12449, 12538, 12540, 12543, 12549, 
-	12591, 12593, 12686, 12704, 12735, 12784, 12799, 13312, 19903, 19968, 42124, 
-	42192, 42237, 42240, 42508, 42512, 42527, 42538, 42539, 42560, 42606, 42623, 
-	42653, 42656, 42725, 42775, 42783, 42786, 42888, 42891, 42954, 42960, 42961, 
-	42963, 42963, 42965, 42969, 42994, 43009, 43011, 43013, 43015, 43018, 43020, 
-	43042, 43072, 43123, 43138, 43187, 43250, 43255, 43259, 43259, 43261, 43262, 
-	43274, 43301, 43312, 43334, 43360, 43388, 43396, 43442, 43471, 43471, 43488, 
-	43492, 43494, 43503, 43514, 43518, 43520, 43560, 43584, 43586, 43588, 43595, 
-	43616, 43638, 43642, 43642, 43646, 43695, 43697, 43697, 43701, 43702, 43705, 
-	43709, 43712, 43712, 43714, 43714, 43739, 43741, 43744, 43754, 43762, 43764, 
-	43777, 43782, 43785, 43790, 43793, 43798, 43808, 43814, 43816, 43822, 43824, 
-	43866, 43868, 43881, 43888, 44002, 44032, 55203, 55216, 55238, 55243, 55291, 
-	63744, 64109, 64112, 64217, 64256, 64262, 64275, 64279, 64285, 64285, 64287, 
-	64296, 64298, 64310, 64312, 64316, 64318, 64318, 64320, 64321, 64323, 64324, 
-	64326, 64433, 64467, 64829, 64848, 64911, 64914, 64967, 65008, 65019, 65136, 
-	65140, 65142, 65276, 65313, 65338, 65345, 65370, 65382, 65470, 65474, 65479, 
-	65482, 65487, 65490, 65495, 65498, 65500, 65536, 65547, 65549, 65574, 65576, 
-	65594, 65596, 65597, 65599, 65613, 65616, 65629, 65664, 65786, 66176, 66204, 
-	66208, 66256, 66304, 66335, 66349, 66368, 66370, 66377, 66384, 66421, 66432, 
-	66461, 66464, 66499, 66504, 66511, 66560, 66717, 66736, 66771, 66776, 66811, 
-	66816, 66855, 66864, 66915, 66928, 66938, 66940, 66954, 66956, 66962, 66964, 
-	66965, 66967, 66977, 66979, 66993, 66995, 67001, 67003, 67004, 67072, 67382, 
-	67392, 67413, 67424, 67431, 67456, 67461, 67463, 67504, 67506, 67514, 67584, 
-	67589, 67592, 67592, 67594, 67637, 67639, 67640, 67644, 67644, 67647, 67669, 
-	67680, 67702, 67712, 67742, 67808, 67826, 67828, 67829, 67840, 67861, 67872, 
-	67897, 67968, 68023, 68030, 68031, 68096, 68096, 68112, 68115, 68117, 68119, 
-	68121, 68149, 68192, 68220, 68224, 68252, 68288, 68295, 68297, 68324, 68352, 
-	68405, 68416, 68437, 68448, 68466, 68480, 68497, 68608, 68680, 68736, 68786, 
-	68800, 68850, 68864, 68899, 69248, 69289, 69296, 69297, 69376, 69404, 69415, 
-	69415, 69424, 69445, 69488, 69505, 69552, 69572, 69600, 69622, 69635, 69687, 
-	69745, 69746, 69749, 69749, 69763, 69807, 69840, 69864, 69891, 69926, 69956, 
-	69956, 69959, 69959, 69968, 70002, 70006, 70006, 70019, 70066, 70081, 70084, 
-	70106, 70106, 70108, 70108, 70144, 70161, 70163, 70187, 70207, 70208, 70272, 
-	70278, 70280, 70280, 70282, 70285, 70287, 70301, 70303, 70312, 70320, 70366, 
-	70405, 70412, 70415, 70416, 70419, 70440, 70442, 70448, 70450, 70451, 70453, 
-	70457, 70461, 70461, 70480, 70480, 70493, 70497, 70656, 70708, 70727, 70730, 
-	70751, 70753, 70784, 70831, 70852, 70853, 70855, 70855, 71040, 71086, 71128, 
-	71131, 71168, 71215, 71236, 71236, 71296, 71338, 71352, 71352, 71424, 71450, 
-	71488, 71494, 71680, 71723, 71840, 71903, 71935, 71942, 71945, 71945, 71948, 
-	71955, 71957, 71958, 71960, 71983, 71999, 71999, 72001, 72001, 72096, 72103, 
-	72106, 72144, 72161, 72161, 72163, 72163, 72192, 72192, 72203, 72242, 72250, 
-	72250, 72272, 72272, 72284, 72329, 72349, 72349, 72368, 72440, 72704, 72712, 
-	72714, 72750, 72768, 72768, 72818, 72847, 72960, 72966, 72968, 72969, 72971, 
-	73008, 73030, 73030, 73056, 73061, 73063, 73064, 73066, 73097, 73112, 73112, 
-	73440, 73458, 73474, 73474, 73476, 73488, 73490, 73523, 73648, 73648, 73728, 
-	74649, 74880, 75075, 77712, 77808, 77824, 78895, 78913, 78918, 82944, 83526, 
-	92160, 92728, 92736, 92766, 92784, 92862, 92880, 92909, 92928, 92975, 92992, 
-	92995, 93027, 93047, 93053, 93071, 93760, 93823, 93952, 94026, 94032, 94032, 
-	94099, 94111, 94176, 94177, 94179, 94179, 94208, 100343, 100352, 101589, 
-	101632, 101640, 110576, 110579, 110581, 110587, 110589, 110590, 110592, 
-	110882, 110898, 110898, 110928, 110930, 110933, 110933, 110948, 110951, 
-	110960, 111355, 113664, 113770, 113776, 113788, 113792, 113800, 113808, 
-	113817, 119808, 119892, 119894, 119964, 119966, 119967, 119970, 119970, 
-	119973, 119974, 119977, 119980, 119982, 119993, 119995, 119995, 119997, 
-	120003, 120005, 120069, 120071, 120074, 120077, 120084, 120086, 120092, 
-	120094, 120121, 120123, 120126, 120128, 120132, 120134, 120134, 120138, 
-	120144, 120146, 120485, 120488, 120512, 120514, 120538, 120540, 120570, 
-	120572, 120596, 120598, 120628, 120630, 120654, 120656, 120686, 120688, 
-	120712, 120714, 120744, 120746, 120770, 120772, 120779, 122624, 122654, 
-	122661, 122666, 122928, 122989, 123136, 123180, 123191, 123197, 123214, 
-	123214, 123536, 123565, 123584, 123627, 124112, 124139, 124896, 124902, 
-	124904, 124907, 124909, 124910, 124912, 124926, 124928, 125124, 125184, 
-	125251, 125259, 125259, 126464, 126467, 126469, 126495, 126497, 126498, 
-	126500, 126500, 126503, 126503, 126505, 126514, 126516, 126519, 126521, 
-	126521, 126523, 126523, 126530, 126530, 126535, 126535, 126537, 126537, 
-	126539, 126539, 126541, 126543, 126545, 126546, 126548, 126548, 126551, 
-	126551, 126553, 126553, 126555, 126555, 126557, 126557, 126559, 126559, 
-	126561, 126562, 126564, 126564, 126567, 126570, 126572, 126578, 126580, 
-	126583, 126585, 126588, 126590, 126590, 126592, 126601, 126603, 126619, 
-	126625, 126627, 126629, 126633, 126635, 126651, 131072, 173791, 173824, 
-	177977, 177984, 178205, 178208, 183969, 183984, 191456, 194560, 195101, 
-	196608, 201546, 201552, 205743, 7, 0, 33, 34, 39, 47, 58, 59, 61, 61, 63, 
-	64, 91, 91, 93, 93, 4, 0, 35, 38, 60, 60, 62, 62, 94, 95, 1, 0, 48, 57, 
-	1, 0, 49, 57, 2, 0, 9, 9, 32, 32, 286, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 
-	0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 
-	0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 
-	0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 
-	0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 
-	1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 
-	45, 1, 0, 0, 0, 1, 47, 1, 0, 0, 0, 3, 63, 1, 0, 0, 0, 5, 66, 1, 0, 0, 0, 
-	7, 86, 1, 0, 0, 0, 9, 88, 1, 0, 0, 0, 11, 106, 1, 0, 0, 0, 13, 129, 1, 
-	0, 0, 0, 15, 131, 1, 0, 0, 0, 17, 133, 1, 0, 0, 0, 19, 139, 1, 0, 0, 0, 
-	21, 155, 1, 0, 0, 0, 23, 169, 1, 0, 0, 0, 25, 187, 1, 0, 0, 0, 27, 203, 
-	1, 0, 0, 0, 29, 220, 1, 0, 0, 0, 31, 236, 1, 0, 0, 0, 33, 241, 1, 0, 0, 
-	0, 35, 245, 1, 0, 0, 0, 37, 248, 1, 0, 0, 0, 39, 267, 1, 0, 0, 0, 41, 269, 
-	1, 0, 0, 0, 43, 272, 1, 0, 0, 0, 45, 276, 1, 0, 0, 0, 47, 48, 5, 92, 0, 
-	0, 48, 49, 5, 116, 0, 0, 49, 50, 5, 101, 0, 0, 50, 51, 5, 120, 0, 0, 51, 
-	52, 5, 116, 0, 0, 52, 53, 5, 98, 0, 0, 53, 54, 5, 102, 0, 0, 54, 55, 5, 
-	123, 0, 0, 55, 56, 5, 84, 0, 0, 56, 57, 5, 105, 0, 0, 57, 58, 5, 116, 0, 
-	0, 58, 59, 5, 108, 0, 0, 59, 60, 5, 101, 0, 0, 60, 61, 5, 58, 0, 0, 61, 
-	62, 5, 125, 0, 0, 62, 2, 1, 0, 0, 0, 63, 64, 5, 92, 0, 0, 64, 65, 5, 92, 
-	0, 0, 65, 4, 1, 0, 0, 0, 66, 67, 5, 92, 0, 0, 67, 68, 5, 116, 0, 0, 68, 
-	69, 5, 101, 0, 0, 69, 70, 5, 120, 0, 0, 70, 71, 5, 116, 0, 0, 71, 72, 5, 
-	98, 0, 0, 72, 73, 5, 102, 0, 0, 73, 74, 5, 123, 0, 0, 74, 75, 5, 85, 0, 
-	0, 75, 76, 5, 82, 0, 0, 76, 77, 5, 76, 0, 0, 77, 78, 5, 58, 0, 0, 78, 79, 
-	5, 125, 0, 0, 79, 80, 5, 32, 0, 0, 80, 81, 5, 92, 0, 0, 81, 82, 5, 117, 
-	0, 0, 82, 83, 5, 114, 0, 0, 83, 84, 5, 108, 0, 0, 84, 85, 5, 123, 0, 0, 
-	85, 6, 1, 0, 0, 0, 86, 87, 5, 125, 0, 0, 87, 8, 1, 0, 0, 0, 88, 89, 5, 
-	92, 0, 0, 89, 90, 5, 116, 0, 0, 90, 91, 5, 101, 0, 0, 91, 92, 5, 120, 0, 
-	0, 92, 93, 5, 116, 0, 0, 93, 94, 5, 98, 0, 0, 94, 95, 5, 102, 0, 0, 95, 
-	96, 5, 123, 0, 0, 96, 97, 5, 67, 0, 0, 97, 98, 5, 114, 0, 0, 98, 99, 5, 
-	101, 0, 0, 99, 100, 5, 97, 0, 0, 100, 101, 5, 116, 0, 0, 101, 102, 5, 101, 
-	0, 0, 102, 103, 5, 100, 0, 0, 103, 104, 5, 58, 0, 0, 104, 105, 5, 125, 
-	0, 0, 105, 10, 1, 0, 0, 0, 106, 107, 5, 92, 0, 0, 107, 108, 5, 116, 0, 
-	0, 108, 109, 5, 101, 0, 0, 109, 110, 5, 120, 0, 0, 110, 111, 5, 116, 0, 
-	0, 111, 112, 5, 98, 0, 0, 112, 113, 5, 102, 0, 0, 113, 114, 5, 123, 0, 
-	0, 114, 115, 5, 76, 0, 0, 115, 116, 5, 97, 0, 0, 116, 117, 5, 115, 0, 0, 
-	117, 118, 5, 116, 0, 0, 118, 119, 5, 32, 0, 0, 119, 120, 5, 85, 0, 0, 120, 
-	121, 5, 112, 0, 0, 121, 122, 5, 100, 0, 0, 122, 123, 5, 97, 0, 0, 123, 
-	124, 5, 116, 0, 0, 124, 125, 5, 101, 0, 0, 125, 126, 5, 100, 0, 0, 126, 
-	127, 5, 58, 0, 0, 127, 128, 5, 125, 0, 0, 128, 12, 1, 0, 0, 0, 129, 130, 
-	5, 92, 0, 0, 130, 14, 1, 0, 0, 0, 131, 132, 5, 123, 0, 0, 132, 16, 1, 0, 
-	0, 0, 133, 134, 5, 92, 0, 0, 134, 135, 5, 105, 0, 0, 135, 136, 5, 116, 
-	0, 0, 136, 137, 5, 101, 0, 0, 137, 138, 5, 109, 0, 0, 138, 18, 1, 0, 0, 
-	0, 139, 140, 5, 92, 0, 0, 140, 141, 5, 98, 0, 0, 141, 142, 5, 101, 0, 0, 
-	142, 143, 5, 103, 0, 0, 143, 144, 5, 105, 0, 0, 144, 145, 5, 110, 0, 0, 
-	145, 146, 5, 123, 0, 0, 146, 147, 5, 105, 0, 0, 147, 148, 5, 116, 0, 0, 
-	148, 149, 5, 101, 0, 0, 149, 150, 5, 109, 0, 0, 150, 151, 5, 105, 0, 0, 
-	151, 152, 5, 122, 0, 0, 152, 153, 5, 101, 0, 0, 153, 154, 5, 125, 0, 0, 
-	154, 20, 1, 0, 0, 0, 155, 156, 5, 92, 0, 0, 156, 157, 5, 101, 0, 0, 157, 
-	158, 5, 110, 0, 0, 158, 159, 5, 100, 0, 0, 159, 160, 5, 123, 0, 0, 160, 
-	161, 5, 105, 0, 0, 161, 162, 5, 116, 0, 0, 162, 163, 5, 101, 0, 0, 163, 
-	164, 5, 109, 0, 0, 164, 165, 5, 105, 0, 0, 165, 166, 5, 122, 0, 0, 166, 
-	167, 5, 101, 0, 0, 167, 168, 5, 125, 0, 0, 168, 22, 1, 0, 0, 0, 169, 170, 
-	5, 92, 0, 0, 170, 171, 5, 98, 0, 0, 171, 172, 5, 101, 0, 0, 172, 173, 5, 
-	103, 0, 0, 173, 174, 5, 105, 0, 0, 174, 175, 5, 110, 0, 0, 175, 176, 5, 
-	123, 0, 0, 176, 177, 5, 101, 0, 0, 177, 178, 5, 110, 0, 0, 178, 179, 5, 
-	117, 0, 0, 179, 180, 5, 109, 0, 0, 180, 181, 5, 101, 0, 0, 181, 182, 5, 
-	114, 0, 0, 182, 183, 5, 97, 0, 0, 183, 184, 5, 116, 0, 0, 184, 185, 5, 
-	101, 0, 0, 185, 186, 5, 125, 0, 0, 186, 24, 1, 0, 0, 0, 187, 188, 5, 92, 
-	0, 0, 188, 189, 5, 101, 0, 0, 189, 190, 5, 110, 0, 0, 190, 191, 5, 100, 
-	0, 0, 191, 192, 5, 123, 0, 0, 192, 193, 5, 101, 0, 0, 193, 194, 5, 110, 
-	0, 0, 194, 195, 5, 117, 0, 0, 195, 196, 5, 109, 0, 0, 196, 197, 5, 101, 
-	0, 0, 197, 198, 5, 114, 0, 0, 198, 199, 5, 97, 0, 0, 199, 200, 5, 116, 
-	0, 0, 200, 201, 5, 101, 0, 0, 201, 202, 5, 125, 0, 0, 202, 26, 1, 0, 0, 
-	0, 203, 204, 5, 92, 0, 0, 204, 205, 5, 98, 0, 0, 205, 206, 5, 101, 0, 0, 
-	206, 207, 5, 103, 0, 0, 207, 208, 5, 105, 0, 0, 208, 209, 5, 110, 0, 0, 
-	209, 210, 5, 123, 0, 0, 210, 211, 5, 118, 0, 0, 211, 212, 5, 101, 0, 0, 
-	212, 213, 5, 114, 0, 0, 213, 214, 5, 98, 0, 0, 214, 215, 5, 97, 0, 0, 215, 
-	216, 5, 116, 0, 0, 216, 217, 5, 105, 0, 0, 217, 218, 5, 109, 0, 0, 218, 
-	219, 5, 125, 0, 0, 219, 28, 1, 0, 0, 0, 220, 221, 5, 92, 0, 0, 221, 222, 
-	5, 101, 0, 0, 222, 223, 5, 110, 0, 0, 223, 224, 5, 100, 0, 0, 224, 225, 
-	5, 123, 0, 0, 225, 226, 5, 118, 0, 0, 226, 227, 5, 101, 0, 0, 227, 228, 
-	5, 114, 0, 0, 228, 229, 5, 98, 0, 0, 229, 230, 5, 97, 0, 0, 230, 231, 5, 
-	116, 0, 0, 231, 232, 5, 105, 0, 0, 232, 233, 5, 109, 0, 0, 233, 234, 5, 
-	125, 0, 0, 234, 30, 1, 0, 0, 0, 235, 237, 7, 0, 0, 0, 236, 235, 1, 0, 0, 
-	0, 237, 238, 1, 0, 0, 0, 238, 236, 1, 0, 0, 0, 238, 239, 1, 0, 0, 0, 239, 
-	32, 1, 0, 0, 0, 240, 242, 7, 1, 0, 0, 241, 240, 1, 0, 0, 0, 242, 243, 1, 
-	0, 0, 0, 243, 241, 1, 0, 0, 0, 243, 244, 1, 0, 0, 0, 244, 34, 1, 0, 0, 
-	0, 245, 246, 7, 2, 0, 0, 246, 36, 1, 0, 0, 0, 247, 249, 5, 45, 0, 0, 248, 
-	247, 1, 0, 0, 0, 248, 249, 1, 0, 0, 0, 249, 250, 1, 0, 0, 0, 250, 257, 
-	3, 39, 19, 0, 251, 253, 5, 46, 0, 0, 252, 254, 7, 3, 0, 0, 253, 252, 1, 
-	0, 0, 0, 254, 255, 1, 0, 0, 0, 255, 253, 1, 0, 0, 0, 255, 256, 1, 0, 0, 
-	0, 256, 258, 1, 0, 0, 0, 257, 251, 1, 0, 0, 0, 257, 258, 1, 0, 0, 0, 258, 
-	38, 1, 0, 0, 0, 259, 268, 5, 48, 0, 0, 260, 264, 7, 4, 0, 0, 261, 263, 
-	7, 3, 0, 0, 262, 261, 1, 0, 0, 0, 263, 266, 1, 0, 0, 0, 264, 262, 1, 0, 
-	0, 0, 264, 265, 1, 0, 0, 0, 265, 268, 1, 0, 0, 0, 266, 264, 1, 0, 0, 0, 
-	267, 259, 1, 0, 0, 0, 267, 260, 1, 0, 0, 0, 268, 40, 1, 0, 0, 0, 269, 270, 
-	5, 10, 0, 0, 270, 42, 1, 0, 0, 0, 271, 273, 7, 5, 0, 0, 272, 271, 1, 0, 
-	0, 0, 273, 274, 1, 0, 0, 0, 274, 272, 1, 0, 0, 0, 274, 275, 1, 0, 0, 0, 
-	275, 44, 1, 0, 0, 0, 276, 277, 5, 13, 0, 0, 277, 278, 1, 0, 0, 0, 278, 
-	279, 6, 22, 0, 0, 279, 46, 1, 0, 0, 0, 9, 0, 238, 243, 248, 255, 257, 264, 
-	267, 274, 1, 6, 0, 0,
+	4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 
+	6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 
+	7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 
+	9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 
+	1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 
+	12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 
+	1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 
+	15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 
+	1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 
+	16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 
+	1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 
+	17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 
+	1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 
+	19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 
+	1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 
+	20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 
+	1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 
+	21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 
+	1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 
+	23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 
+	1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 
+	24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 
+	1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 
+	26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 4, 26, 
+	413, 8, 26, 11, 26, 12, 26, 414, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 
+	27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 
+	1, 27, 1, 27, 1, 27, 1, 27, 5, 27, 437, 8, 27, 10, 27, 12, 27, 440, 9, 
+	27, 1, 27, 1, 27, 5, 27, 444, 8, 27, 10, 27, 12, 27, 447, 9, 27, 1, 27, 
+	3, 27, 450, 8, 27, 1, 27, 5, 27, 453, 8, 27, 10, 27, 12, 27, 456, 9, 27, 
+	1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 5, 27, 464, 8, 27, 10, 27, 12, 
+	27, 467, 9, 27, 1, 27, 5, 27, 470, 8, 27, 10, 27, 12, 27, 473, 9, 27, 1, 
+	27, 5, 27, 476, 8, 27, 10, 27, 12, 27, 479, 9, 27, 1, 27, 1, 27, 1, 28, 
+	1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 
+	28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 
+	1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 
+	28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 5, 28, 
+	525, 8, 28, 10, 28, 12, 28, 528, 9, 28, 1, 28, 3, 28, 531, 8, 28, 1, 29, 
+	1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 5, 29, 540, 8, 29, 10, 29, 12, 
+	29, 543, 9, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 
+	1, 30, 1, 30, 5, 30, 555, 8, 30, 10, 30, 12, 30, 558, 9, 30, 1, 30, 1, 
+	30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 4, 32, 568, 8, 32, 11, 32, 
+	12, 32, 569, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 5, 32, 577, 8, 32, 10, 
+	32, 12, 32, 580, 9, 32, 1, 32, 1, 32, 3, 32, 584, 8, 32, 1, 33, 1, 33, 
+	1, 33, 1, 33, 5, 33, 590, 8, 33, 10, 33, 12, 33, 593, 9, 33, 1, 33, 1, 
+	33, 1, 33, 1, 33, 1, 33, 1, 33, 5, 33, 601, 8, 33, 10, 33, 12, 33, 604, 
+	9, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 
+	33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 
+	5, 33, 626, 8, 33, 10, 33, 12, 33, 629, 9, 33, 1, 33, 1, 33, 1, 33, 1, 
+	33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 
+	1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 
+	33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 5, 33, 664, 
+	8, 33, 10, 33, 12, 33, 667, 9, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 
+	33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 
+	684, 8, 33, 1, 34, 1, 34, 1, 34, 1, 35, 4, 35, 690, 8, 35, 11, 35, 12, 
+	35, 691, 1, 36, 4, 36, 695, 8, 36, 11, 36, 12, 36, 696, 1, 37, 1, 37, 1, 
+	38, 1, 38, 1, 39, 3, 39, 704, 8, 39, 1, 39, 1, 39, 1, 39, 4, 39, 709, 8, 
+	39, 11, 39, 12, 39, 710, 3, 39, 713, 8, 39, 1, 40, 1, 40, 1, 40, 5, 40, 
+	718, 8, 40, 10, 40, 12, 40, 721, 9, 40, 3, 40, 723, 8, 40, 1, 41, 1, 41, 
+	1, 42, 4, 42, 728, 8, 42, 11, 42, 12, 42, 729, 1, 43, 1, 43, 1, 43, 1, 
+	43, 5, 578, 591, 602, 627, 665, 0, 44, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 
+	6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15, 
+	31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24, 
+	49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61, 31, 63, 0, 65, 32, 
+	67, 33, 69, 34, 71, 35, 73, 36, 75, 37, 77, 38, 79, 39, 81, 0, 83, 40, 
+	85, 41, 87, 42, 1, 0, 12, 5, 0, 10, 10, 13, 13, 58, 58, 123, 123, 125, 
+	125, 2, 0, 9, 9, 32, 32, 3, 0, 10, 10, 13, 13, 93, 93, 4, 0, 10, 10, 13, 
+	13, 123, 123, 125, 125, 3, 0, 10, 10, 13, 13, 125, 125, 2, 0, 10, 10, 13, 
+	13, 4, 0, 10, 10, 13, 13, 36, 36, 92, 92, 659, 0, 65, 90, 97, 122, 170, 
+	170, 181, 181, 186, 186, 192, 214, 216, 246, 248, 705, 710, 721, 736, 740, 
+	748, 748, 750, 750, 880, 884, 886, 887, 890, 893, 895, 895, 902, 902, 904, 
+	906, 908, 908, 910, 929, 931, 1013, 1015, 1153, 1162, 1327, 1329, 1366, 
+	1369, 1369, 1376, 1416, 1488, 1514, 1519, 1522, 1568, 1610, 1646, 1647, 
+	1649, 1747, 1749, 1749, 1765, 1766, 1774, 1775, 1786, 1788, 1791, 1791, 
+	1808, 1808, 1810, 1839, 1869, 1957, 1969, 1969, 1994, 2026, 2036, 2037, 
+	2042, 2042, 2048, 2069, 2074, 2074, 2084, 2084, 2088, 2088, 2112, 2136, 
+	2144, 2154, 2160, 2183, 2185, 2190, 2208, 2249, 2308, 2361, 2365, 2365, 
+	2384, 2384, 2392, 2401, 2417, 2432, 2437, 2444, 2447, 2448, 2451, 2472, 
+	2474, 2480, 2482, 2482, 2486, 2489, 2493, 2493, 2510, 2510, 2524, 2525, 
+	2527, 2529, 2544, 2545, 2556, 2556, 2565, 2570, 2575, 2576, 2579, 2600, 
+	2602, 2608, 2610, 2611, 2613, 2614, 2616, 2617, 2649, 2652, 2654, 2654, 
+	2674, 2676, 2693, 2701, 2703, 2705, 2707, 2728, 2730, 2736, 2738, 2739, 
+	2741, 2745, 2749, 2749, 2768, 2768, 2784, 2785, 2809, 2809, 2821, 2828, 
+	2831, 2832, 2835, 2856, 2858, 2864, 2866, 2867, 2869, 2873, 2877, 2877, 
+	2908, 2909, 2911, 2913, 2929, 2929, 2947, 2947, 2949, 2954, 2958, 2960, 
+	2962, 2965, 2969, 2970, 2972, 2972, 2974, 2975, 2979, 2980, 2984, 2986, 
+	2990, 3001, 3024, 3024, 3077, 3084, 3086, 3088, 3090, 3112, 3114, 3129, 
+	3133, 3133, 3160, 3162, 3165, 3165, 3168, 3169, 3200, 3200, 3205, 3212, 
+	3214, 3216, 3218, 3240, 3242, 3251, 3253, 3257, 3261, 3261, 3293, 3294, 
+	3296, 3297, 3313, 3314, 3332, 3340, 3342, 3344, 3346, 3386, 3389, 3389, 
+	3406, 3406, 3412, 3414, 3423, 3425, 3450, 3455, 3461, 3478, 3482, 3505, 
+	3507, 3515, 3517, 3517, 3520, 3526, 3585, 3632, 3634, 3635, 3648, 3654, 
+	3713, 3714, 3716, 3716, 3718, 3722, 3724, 3747, 3749, 3749, 3751, 3760, 
+	3762, 3763, 3773, 3773, 3776, 3780, 3782, 3782, 3804, 3807, 3840, 3840, 
+	3904, 3911, 3913, 3948, 3976, 3980, 4096, 4138, 4159, 4159, 4176, 4181, 
+	4186, 4189, 4193, 4193, 4197, 4198, 4206, 4208, 4213, 4225, 4238, 4238, 
+	4256, 4293, 4295, 4295, 4301, 4301, 4304, 4346, 4348, 4680, 4682, 4685, 
+	4688, 4694, 4696, 4696, 4698, 4701, 4704, 4744, 4746, 4749, 4752, 4784, 
+	4786, 4789, 4792, 4798, 4800, 4800, 4802, 4805, 4808, 4822, 4824, 4880, 
+	4882, 4885, 4888, 4954, 4992, 5007, 5024, 5109, 5112, 5117, 5121, 5740, 
+	5743, 5759, 5761, 5786, 5792, 5866, 5873, 5880, 5888, 5905, 5919, 5937, 
+	5952, 5969, 5984, 5996, 5998, 6000, 6016, 6067, 6103, 6103, 6108, 6108, 
+	6176, 6264, 6272, 6276, 6279, 6312, 6314, 6314, 6320, 6389, 6400, 6430, 
+	6480, 6509, 6512, 6516, 6528, 6571, 6576, 6601, 6656, 6678, 6688, 6740, 
+	6823, 6823, 6917, 6963, 6981, 6988, 7043, 7072, 7086, 7087, 7098, 7141, 
+	7168, 7203, 7245, 7247, 7258, 7293, 7296, 7304, 7312, 7354, 7357, 7359, 
+	7401, 7404, 7406, 7411, 7413, 7414, 7418, 7418, 7424, 7615, 7680, 7957, 
+	7960, 7965, 7968, 8005, 8008, 8013, 8016, 8023, 8025, 8025, 8027, 8027, 
+	8029, 8029, 8031, 8061, 8064, 8116, 8118, 8124, 8126, 8126, 8130, 8132, 
+	8134, 8140, 8144, 8147, 8150, 8155, 8160, 8172, 8178, 8180, 8182, 8188, 
+	8305, 8305, 8319, 8319, 8336, 8348, 8450, 8450, 8455, 8455, 8458, 8467, 
+	8469, 8469, 8473, 8477, 8484, 8484, 8486, 8486, 8488, 8488, 8490, 8493, 
+	8495, 8505, 8508, 8511, 8517, 8521, 8526, 8526, 8579, 8580, 11264, 11492, 
+	11499, 11502, 11506, 11507, 11520, 11557, 11559, 11559, 11565, 11565, 11568, 
+	11623, 11631, 11631, 11648, 11670, 11680, 11686, 11688, 11694, 11696, 11702, 
+	11704, 11710, 11712, 11718, 11720, 11726, 11728, 11734, 11736, 11742, 11823, 
+	11823, 12293, 12294, 12337, 12341, 12347, 12348, 12353, 12438, 12445, 12447, 
+	12449, 12538, 12540, 12543, 12549, 12591, 12593, 12686, 12704, 12735, 12784, 
+	12799, 13312, 19903, 19968, 42124, 42192, 42237, 42240, 42508, 42512, 42527, 
+	42538, 42539, 42560, 42606, 42623, 42653, 42656, 42725, 42775, 42783, 42786, 
+	42888, 42891, 42954, 42960, 42961, 42963, 42963, 42965, 42969, 42994, 43009, 
+	43011, 43013, 43015, 43018, 43020, 43042, 43072, 43123, 43138, 43187, 43250, 
+	43255, 43259, 43259, 43261, 43262, 43274, 43301, 43312, 43334, 43360, 43388, 
+	43396, 43442, 43471, 43471, 43488, 43492, 43494, 43503, 43514, 43518, 43520, 
+	43560, 43584, 43586, 43588, 43595, 43616, 43638, 43642, 43642, 43646, 43695, 
+	43697, 43697, 43701, 43702, 43705, 43709, 43712, 43712, 43714, 43714, 43739, 
+	43741, 43744, 43754, 43762, 43764, 43777, 43782, 43785, 43790, 43793, 43798, 
+	43808, 43814, 43816, 43822, 43824, 43866, 43868, 43881, 43888, 44002, 44032, 
+	55203, 55216, 55238, 55243, 55291, 63744, 64109, 64112, 64217, 64256, 64262, 
+	64275, 64279, 64285, 64285, 64287, 64296, 64298, 64310, 64312, 64316, 64318, 
+	64318, 64320, 64321, 64323, 64324, 64326, 64433, 64467, 64829, 64848, 64911, 
+	64914, 64967, 65008, 65019, 65136, 65140, 65142, 65276, 65313, 65338, 65345, 
+	65370, 65382, 65470, 65474, 65479, 65482, 65487, 65490, 65495, 65498, 65500, 
+	65536, 65547, 65549, 65574, 65576, 65594, 65596, 65597, 65599, 65613, 65616, 
+	65629, 65664, 65786, 66176, 66204, 66208, 66256, 66304, 66335, 66349, 66368, 
+	66370, 66377, 66384, 66421, 66432, 66461, 66464, 66499, 66504, 66511, 66560, 
+	66717, 66736, 66771, 66776, 66811, 66816, 66855, 66864, 66915, 66928, 66938, 
+	66940, 66954, 66956, 66962, 66964, 66965, 66967, 66977, 66979, 66993, 66995, 
+	67001, 67003, 67004, 67072, 67382, 67392, 67413, 67424, 67431, 67456, 67461, 
+	67463, 67504, 67506, 67514, 67584, 67589, 67592, 67592, 67594, 67637, 67639, 
+	67640, 67644, 67644, 67647, 67669, 67680, 67702, 67712, 67742, 67808, 67826, 
+	67828, 67829, 67840, 67861, 67872, 67897, 67968, 68023, 68030, 68031, 68096, 
+	68096, 68112, 68115, 68117, 68119, 68121, 68149, 68192, 68220, 68224, 68252, 
+	68288, 68295, 68297, 68324, 68352, 68405, 68416, 68437, 68448, 68466, 68480, 
+	68497, 68608, 68680, 68736, 68786, 68800, 68850, 68864, 68899, 69248, 69289, 
+	69296, 69297, 69376, 69404, 69415, 69415, 69424, 69445, 69488, 69505, 69552, 
+	69572, 69600, 69622, 69635, 69687, 69745, 69746, 69749, 69749, 69763, 69807, 
+	69840, 69864, 69891, 69926, 69956, 69956, 69959, 69959, 69968, 70002, 70006, 
+	70006, 70019, 70066, 70081, 70084, 70106, 70106, 70108, 70108, 70144, 70161, 
+	70163, 70187, 70207, 70208, 70272, 70278, 70280, 70280, 70282, 70285, 70287, 
+	70301, 70303, 70312, 70320, 70366, 70405, 70412, 70415, 70416, 70419, 70440, 
+	70442, 70448, 70450, 70451, 70453, 70457, 70461, 70461, 70480, 70480, 70493, 
+	70497, 70656, 70708, 70727, 70730, 70751, 70753, 70784, 70831, 70852, 70853, 
+	70855, 70855, 71040, 71086, 71128, 71131, 71168, 71215, 71236, 71236, 71296, 
+	71338, 71352, 71352, 71424, 71450, 71488, 71494, 71680, 71723, 71840, 71903, 
+	71935, 71942, 71945, 71945, 71948, 71955, 71957, 71958, 71960, 71983, 71999, 
+	71999, 72001, 72001, 72096, 72103, 72106, 72144, 72161, 72161, 72163, 72163, 
+	72192, 72192, 72203, 72242, 72250, 72250, 72272, 72272, 72284, 72329, 72349, 
+	72349, 72368, 72440, 72704, 72712, 72714, 72750, 72768, 72768, 72818, 72847, 
+	72960, 72966, 72968, 72969, 72971, 73008, 73030, 73030, 73056, 73061, 73063, 
+	73064, 73066, 73097, 73112, 73112, 73440, 73458, 73474, 73474, 73476, 73488, 
+	73490, 73523, 73648, 73648, 73728, 74649, 74880, 75075, 77712, 77808, 77824, 
+	78895, 78913, 78918, 82944, 83526, 92160, 92728, 92736, 92766, 92784, 92862, 
+	92880, 92909, 92928, 92975, 92992, 92995, 93027, 93047, 93053, 93071, 93760, 
+	93823, 93952, 94026, 94032, 94032, 94099, 94111, 94176, 94177, 94179, 94179, 
+	94208, 100343, 100352, 101589, 101632, 101640, 110576, 110579, 110581, 
+	110587, 110589, 110590, 110592, 110882, 110898, 110898, 110928, 110930, 
+	110933, 110933, 110948, 110951, 110960, 111355, 113664, 113770, 113776, 
+	113788, 113792, 113800, 113808, 113817, 119808, 119892, 119894, 119964, 
+	119966, 119967, 119970, 119970, 119973, 119974, 119977, 119980, 119982, 
+	119993, 119995, 119995, 119997, 120003, 120005, 120069, 120071, 120074, 
+	120077, 120084, 120086, 120092, 120094, 120121, 120123, 120126, 120128, 
+	120132, 120134, 120134, 120138, 120144, 120146, 120485, 120488, 120512, 
+	120514, 120538, 120540, 120570, 120572, 120596, 120598, 120628, 120630, 
+	120654, 120656, 120686, 120688, 120712, 120714, 120744, 120746, 120770, 
+	120772, 120779, 122624, 122654, 122661, 122666, 122928, 122989, 123136, 
+	123180, 123191, 123197, 123214, 123214, 123536, 123565, 123584, 123627, 
+	124112, 124139, 124896, 124902, 124904, 124907, 124909, 124910, 124912, 
+	124926, 124928, 125124, 125184, 125251, 125259, 125259, 126464, 126467, 
+	126469, 126495, 126497, 126498, 126500, 126500, 126503, 126503, 126505, 
+	126514, 126516, 126519, 126521, 126521, 126523, 126523, 126530, 126530, 
+	126535, 126535, 126537, 126537, 126539, 126539, 126541, 126543, 126545, 
+	126546, 126548, 126548, 126551, 126551, 126553, 126553, 126555, 126555, 
+	126557, 126557, 126559, 126559, 126561, 126562, 126564, 126564, 126567, 
+	126570, 126572, 126578, 126580, 126583, 126585, 126588, 126590, 126590, 
+	126592, 126601, 126603, 126619, 126625, 126627, 126629, 126633, 126635, 
+	126651, 131072, 173791, 173824, 177977, 177984, 178205, 178208, 183969, 
+	183984, 191456, 194560, 195101, 196608, 201546, 201552, 205743, 7, 0, 33, 
+	34, 39, 47, 58, 59, 61, 61, 63, 64, 91, 91, 93, 93, 4, 0, 35, 37, 60, 60, 
+	62, 62, 94, 95, 1, 0, 48, 57, 1, 0, 49, 57, 768, 0, 1, 1, 0, 0, 0, 0, 3, 
+	1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 
+	1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 
+	19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 
+	0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 
+	0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 
+	0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 
+	0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 
+	1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 
+	67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 
+	0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 83, 1, 0, 0, 
+	0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 1, 89, 1, 0, 0, 0, 3, 105, 1, 
+	0, 0, 0, 5, 108, 1, 0, 0, 0, 7, 122, 1, 0, 0, 0, 9, 140, 1, 0, 0, 0, 11, 
+	163, 1, 0, 0, 0, 13, 165, 1, 0, 0, 0, 15, 174, 1, 0, 0, 0, 17, 181, 1, 
+	0, 0, 0, 19, 190, 1, 0, 0, 0, 21, 199, 1, 0, 0, 0, 23, 211, 1, 0, 0, 0, 
+	25, 213, 1, 0, 0, 0, 27, 224, 1, 0, 0, 0, 29, 226, 1, 0, 0, 0, 31, 232, 
+	1, 0, 0, 0, 33, 248, 1, 0, 0, 0, 35, 262, 1, 0, 0, 0, 37, 280, 1, 0, 0, 
+	0, 39, 296, 1, 0, 0, 0, 41, 310, 1, 0, 0, 0, 43, 322, 1, 0, 0, 0, 45, 340, 
+	1, 0, 0, 0, 47, 356, 1, 0, 0, 0, 49, 373, 1, 0, 0, 0, 51, 388, 1, 0, 0, 
+	0, 53, 402, 1, 0, 0, 0, 55, 419, 1, 0, 0, 0, 57, 530, 1, 0, 0, 0, 59, 532, 
+	1, 0, 0, 0, 61, 546, 1, 0, 0, 0, 63, 561, 1, 0, 0, 0, 65, 583, 1, 0, 0, 
+	0, 67, 683, 1, 0, 0, 0, 69, 685, 1, 0, 0, 0, 71, 689, 1, 0, 0, 0, 73, 694, 
+	1, 0, 0, 0, 75, 698, 1, 0, 0, 0, 77, 700, 1, 0, 0, 0, 79, 703, 1, 0, 0, 
+	0, 81, 722, 1, 0, 0, 0, 83, 724, 1, 0, 0, 0, 85, 727, 1, 0, 0, 0, 87, 731, 
+	1, 0, 0, 0, 89, 90, 5, 92, 0, 0, 90, 91, 5, 116, 0, 0, 91, 92, 5, 101, 
+	0, 0, 92, 93, 5, 120, 0, 0, 93, 94, 5, 116, 0, 0, 94, 95, 5, 98, 0, 0, 
+	95, 96, 5, 102, 0, 0, 96, 97, 5, 123, 0, 0, 97, 98, 5, 84, 0, 0, 98, 99, 
+	5, 105, 0, 0, 99, 100, 5, 116, 0, 0, 100, 101, 5, 108, 0, 0, 101, 102, 
+	5, 101, 0, 0, 102, 103, 5, 58, 0, 0, 103, 104, 5, 125, 0, 0, 104, 2, 1, 
+	0, 0, 0, 105, 106, 5, 92, 0, 0, 106, 107, 5, 92, 0, 0, 107, 4, 1, 0, 0, 
+	0, 108, 109, 5, 92, 0, 0, 109, 110, 5, 116, 0, 0, 110, 111, 5, 101, 0, 
+	0, 111, 112, 5, 120, 0, 0, 112, 113, 5, 116, 0, 0, 113, 114, 5, 98, 0, 
+	0, 114, 115, 5, 102, 0, 0, 115, 116, 5, 123, 0, 0, 116, 117, 5, 85, 0, 
+	0, 117, 118, 5, 82, 0, 0, 118, 119, 5, 76, 0, 0, 119, 120, 5, 58, 0, 0, 
+	120, 121, 5, 125, 0, 0, 121, 6, 1, 0, 0, 0, 122, 123, 5, 92, 0, 0, 123, 
+	124, 5, 116, 0, 0, 124, 125, 5, 101, 0, 0, 125, 126, 5, 120, 0, 0, 126, 
+	127, 5, 116, 0, 0, 127, 128, 5, 98, 0, 0, 128, 129, 5, 102, 0, 0, 129, 
+	130, 5, 123, 0, 0, 130, 131, 5, 67, 0, 0, 131, 132, 5, 114, 0, 0, 132, 
+	133, 5, 101, 0, 0, 133, 134, 5, 97, 0, 0, 134, 135, 5, 116, 0, 0, 135, 
+	136, 5, 101, 0, 0, 136, 137, 5, 100, 0, 0, 137, 138, 5, 58, 0, 0, 138, 
+	139, 5, 125, 0, 0, 139, 8, 1, 0, 0, 0, 140, 141, 5, 92, 0, 0, 141, 142, 
+	5, 116, 0, 0, 142, 143, 5, 101, 0, 0, 143, 144, 5, 120, 0, 0, 144, 145, 
+	5, 116, 0, 0, 145, 146, 5, 98, 0, 0, 146, 147, 5, 102, 0, 0, 147, 148, 
+	5, 123, 0, 0, 148, 149, 5, 76, 0, 0, 149, 150, 5, 97, 0, 0, 150, 151, 5, 
+	115, 0, 0, 151, 152, 5, 116, 0, 0, 152, 153, 5, 32, 0, 0, 153, 154, 5, 
+	85, 0, 0, 154, 155, 5, 112, 0, 0, 155, 156, 5, 100, 0, 0, 156, 157, 5, 
+	97, 0, 0, 157, 158, 5, 116, 0, 0, 158, 159, 5, 101, 0, 0, 159, 160, 5, 
+	100, 0, 0, 160, 161, 5, 58, 0, 0, 161, 162, 5, 125, 0, 0, 162, 10, 1, 0, 
+	0, 0, 163, 164, 5, 92, 0, 0, 164, 12, 1, 0, 0, 0, 165, 166, 5, 92, 0, 0, 
+	166, 167, 5, 116, 0, 0, 167, 168, 5, 101, 0, 0, 168, 169, 5, 120, 0, 0, 
+	169, 170, 5, 116, 0, 0, 170, 171, 5, 98, 0, 0, 171, 172, 5, 102, 0, 0, 
+	172, 173, 5, 123, 0, 0, 173, 14, 1, 0, 0, 0, 174, 175, 5, 92, 0, 0, 175, 
+	176, 5, 101, 0, 0, 176, 177, 5, 109, 0, 0, 177, 178, 5, 112, 0, 0, 178, 
+	179, 5, 104, 0, 0, 179, 180, 5, 123, 0, 0, 180, 16, 1, 0, 0, 0, 181, 182, 
+	5, 92, 0, 0, 182, 183, 5, 116, 0, 0, 183, 184, 5, 101, 0, 0, 184, 185, 
+	5, 120, 0, 0, 185, 186, 5, 116, 0, 0, 186, 187, 5, 105, 0, 0, 187, 188, 
+	5, 116, 0, 0, 188, 189, 5, 123, 0, 0, 189, 18, 1, 0, 0, 0, 190, 191, 5, 
+	92, 0, 0, 191, 192, 5, 116, 0, 0, 192, 193, 5, 101, 0, 0, 193, 194, 5, 
+	120, 0, 0, 194, 195, 5, 116, 0, 0, 195, 196, 5, 116, 0, 0, 196, 197, 5, 
+	116, 0, 0, 197, 198, 5, 123, 0, 0, 198, 20, 1, 0, 0, 0, 199, 200, 5, 92, 
+	0, 0, 200, 201, 5, 117, 0, 0, 201, 202, 5, 110, 0, 0, 202, 203, 5, 100, 
+	0, 0, 203, 204, 5, 101, 0, 0, 204, 205, 5, 114, 0, 0, 205, 206, 5, 108, 
+	0, 0, 206, 207, 5, 105, 0, 0, 207, 208, 5, 110, 0, 0, 208, 209, 5, 101, 
+	0, 0, 209, 210, 5, 123, 0, 0, 210, 22, 1, 0, 0, 0, 211, 212, 5, 125, 0, 
+	0, 212, 24, 1, 0, 0, 0, 213, 214, 5, 92, 0, 0, 214, 215, 5, 102, 0, 0, 
+	215, 216, 5, 111, 0, 0, 216, 217, 5, 111, 0, 0, 217, 218, 5, 116, 0, 0, 
+	218, 219, 5, 110, 0, 0, 219, 220, 5, 111, 0, 0, 220, 221, 5, 116, 0, 0, 
+	221, 222, 5, 101, 0, 0, 222, 223, 5, 123, 0, 0, 223, 26, 1, 0, 0, 0, 224, 
+	225, 5, 123, 0, 0, 225, 28, 1, 0, 0, 0, 226, 227, 5, 92, 0, 0, 227, 228, 
+	5, 105, 0, 0, 228, 229, 5, 116, 0, 0, 229, 230, 5, 101, 0, 0, 230, 231, 
+	5, 109, 0, 0, 231, 30, 1, 0, 0, 0, 232, 233, 5, 92, 0, 0, 233, 234, 5, 
+	98, 0, 0, 234, 235, 5, 101, 0, 0, 235, 236, 5, 103, 0, 0, 236, 237, 5, 
+	105, 0, 0, 237, 238, 5, 110, 0, 0, 238, 239, 5, 123, 0, 0, 239, 240, 5, 
+	105, 0, 0, 240, 241, 5, 116, 0, 0, 241, 242, 5, 101, 0, 0, 242, 243, 5, 
+	109, 0, 0, 243, 244, 5, 105, 0, 0, 244, 245, 5, 122, 0, 0, 245, 246, 5, 
+	101, 0, 0, 246, 247, 5, 125, 0, 0, 247, 32, 1, 0, 0, 0, 248, 249, 5, 92, 
+	0, 0, 249, 250, 5, 101, 0, 0, 250, 251, 5, 110, 0, 0, 251, 252, 5, 100, 
+	0, 0, 252, 253, 5, 123, 0, 0, 253, 254, 5, 105, 0, 0, 254, 255, 5, 116, 
+	0, 0, 255, 256, 5, 101, 0, 0, 256, 257, 5, 109, 0, 0, 257, 258, 5, 105, 
+	0, 0, 258, 259, 5, 122, 0, 0, 259, 260, 5, 101, 0, 0, 260, 261, 5, 125, 
+	0, 0, 261, 34, 1, 0, 0, 0, 262, 263, 5, 92, 0, 0, 263, 264, 5, 98, 0, 0, 
+	264, 265, 5, 101, 0, 0, 265, 266, 5, 103, 0, 0, 266, 267, 5, 105, 0, 0, 
+	267, 268, 5, 110, 0, 0, 268, 269, 5, 123, 0, 0, 269, 270, 5, 101, 0, 0, 
+	270, 271, 5, 110, 0, 0, 271, 272, 5, 117, 0, 0, 272, 273, 5, 109, 0, 0, 
+	273, 274, 5, 101, 0, 0, 274, 275, 5, 114, 0, 0, 275, 276, 5, 97, 0, 0, 
+	276, 277, 5, 116, 0, 0, 277, 278, 5, 101, 0, 0, 278, 279, 5, 125, 0, 0, 
+	279, 36, 1, 0, 0, 0, 280, 281, 5, 92, 0, 0, 281, 282, 5, 101, 0, 0, 282, 
+	283, 5, 110, 0, 0, 283, 284, 5, 100, 0, 0, 284, 285, 5, 123, 0, 0, 285, 
+	286, 5, 101, 0, 0, 286, 287, 5, 110, 0, 0, 287, 288, 5, 117, 0, 0, 288, 
+	289, 5, 109, 0, 0, 289, 290, 5, 101, 0, 0, 290, 291, 5, 114, 0, 0, 291, 
+	292, 5, 97, 0, 0, 292, 293, 5, 116, 0, 0, 293, 294, 5, 101, 0, 0, 294, 
+	295, 5, 125, 0, 0, 295, 38, 1, 0, 0, 0, 296, 297, 5, 92, 0, 0, 297, 298, 
+	5, 98, 0, 0, 298, 299, 5, 101, 0, 0, 299, 300, 5, 103, 0, 0, 300, 301, 
+	5, 105, 0, 0, 301, 302, 5, 110, 0, 0, 302, 303, 5, 123, 0, 0, 303, 304, 
+	5, 113, 0, 0, 304, 305, 5, 117, 0, 0, 305, 306, 5, 111, 0, 0, 306, 307, 
+	5, 116, 0, 0, 307, 308, 5, 101, 0, 0, 308, 309, 5, 125, 0, 0, 309, 40, 
+	1, 0, 0, 0, 310, 311, 5, 92, 0, 0, 311, 312, 5, 101, 0, 0, 312, 313, 5, 
+	110, 0, 0, 313, 314, 5, 100, 0, 0, 314, 315, 5, 123, 0, 0, 315, 316, 5, 
+	113, 0, 0, 316, 317, 5, 117, 0, 0, 317, 318, 5, 111, 0, 0, 318, 319, 5, 
+	116, 0, 0, 319, 320, 5, 101, 0, 0, 320, 321, 5, 125, 0, 0, 321, 42, 1, 
+	0, 0, 0, 322, 323, 5, 92, 0, 0, 323, 324, 5, 98, 0, 0, 324, 325, 5, 101, 
+	0, 0, 325, 326, 5, 103, 0, 0, 326, 327, 5, 105, 0, 0, 327, 328, 5, 110, 
+	0, 0, 328, 329, 5, 123, 0, 0, 329, 330, 5, 113, 0, 0, 330, 331, 5, 117, 
+	0, 0, 331, 332, 5, 111, 0, 0, 332, 333, 5, 116, 0, 0, 333, 334, 5, 97, 
+	0, 0, 334, 335, 5, 116, 0, 0, 335, 336, 5, 105, 0, 0, 336, 337, 5, 111, 
+	0, 0, 337, 338, 5, 110, 0, 0, 338, 339, 5, 125, 0, 0, 339, 44, 1, 0, 0, 
+	0, 340, 341, 5, 92, 0, 0, 341, 342, 5, 101, 0, 0, 342, 343, 5, 110, 0, 
+	0, 343, 344, 5, 100, 0, 0, 344, 345, 5, 123, 0, 0, 345, 346, 5, 113, 0, 
+	0, 346, 347, 5, 117, 0, 0, 347, 348, 5, 111, 0, 0, 348, 349, 5, 116, 0, 
+	0, 349, 350, 5, 97, 0, 0, 350, 351, 5, 116, 0, 0, 351, 352, 5, 105, 0, 
+	0, 352, 353, 5, 111, 0, 0, 353, 354, 5, 110, 0, 0, 354, 355, 5, 125, 0, 
+	0, 355, 46, 1, 0, 0, 0, 356, 357, 5, 92, 0, 0, 357, 358, 5, 98, 0, 0, 358, 
+	359, 5, 101, 0, 0, 359, 360, 5, 103, 0, 0, 360, 361, 5, 105, 0, 0, 361, 
+	362, 5, 110, 0, 0, 362, 363, 5, 123, 0, 0, 363, 364, 5, 118, 0, 0, 364, 
+	365, 5, 101, 0, 0, 365, 366, 5, 114, 0, 0, 366, 367, 5, 98, 0, 0, 367, 
+	368, 5, 97, 0, 0, 368, 369, 5, 116, 0, 0, 369, 370, 5, 105, 0, 0, 370, 
+	371, 5, 109, 0, 0, 371, 372, 5, 125, 0, 0, 372, 48, 1, 0, 0, 0, 373, 374, 
+	5, 92, 0, 0, 374, 375, 5, 101, 0, 0, 375, 376, 5, 110, 0, 0, 376, 377, 
+	5, 100, 0, 0, 377, 378, 5, 123, 0, 0, 378, 379, 5, 118, 0, 0, 379, 380, 
+	5, 101, 0, 0, 380, 381, 5, 114, 0, 0, 381, 382, 5, 98, 0, 0, 382, 383, 
+	5, 97, 0, 0, 383, 384, 5, 116, 0, 0, 384, 385, 5, 105, 0, 0, 385, 386, 
+	5, 109, 0, 0, 386, 387, 5, 125, 0, 0, 387, 50, 1, 0, 0, 0, 388, 389, 5, 
+	92, 0, 0, 389, 390, 5, 101, 0, 0, 390, 391, 5, 110, 0, 0, 391, 392, 5, 
+	100, 0, 0, 392, 393, 5, 123, 0, 0, 393, 394, 5, 116, 0, 0, 394, 395, 5, 
+	97, 0, 0, 395, 396, 5, 98, 0, 0, 396, 397, 5, 117, 0, 0, 397, 398, 5, 108, 
+	0, 0, 398, 399, 5, 97, 0, 0, 399, 400, 5, 114, 0, 0, 400, 401, 5, 125, 
+	0, 0, 401, 52, 1, 0, 0, 0, 402, 403, 5, 92, 0, 0, 403, 404, 5, 116, 0, 
+	0, 404, 405, 5, 101, 0, 0, 405, 406, 5, 120, 0, 0, 406, 407, 5, 116, 0, 
+	0, 407, 408, 5, 98, 0, 0, 408, 409, 5, 102, 0, 0, 409, 410, 5, 123, 0, 
+	0, 410, 412, 1, 0, 0, 0, 411, 413, 8, 0, 0, 0, 412, 411, 1, 0, 0, 0, 413, 
+	414, 1, 0, 0, 0, 414, 412, 1, 0, 0, 0, 414, 415, 1, 0, 0, 0, 415, 416, 
+	1, 0, 0, 0, 416, 417, 5, 58, 0, 0, 417, 418, 5, 125, 0, 0, 418, 54, 1, 
+	0, 0, 0, 419, 420, 5, 92, 0, 0, 420, 421, 5, 98, 0, 0, 421, 422, 5, 101, 
+	0, 0, 422, 423, 5, 103, 0, 0, 423, 424, 5, 105, 0, 0, 424, 425, 5, 110, 
+	0, 0, 425, 426, 5, 123, 0, 0, 426, 427, 5, 116, 0, 0, 427, 428, 5, 97, 
+	0, 0, 428, 429, 5, 98, 0, 0, 429, 430, 5, 117, 0, 0, 430, 431, 5, 108, 
+	0, 0, 431, 432, 5, 97, 0, 0, 432, 433, 5, 114, 0, 0, 433, 434, 5, 125, 
+	0, 0, 434, 438, 1, 0, 0, 0, 435, 437, 7, 1, 0, 0, 436, 435, 1, 0, 0, 0, 
+	437, 440, 1, 0, 0, 0, 438, 436, 1, 0, 0, 0, 438, 439, 1, 0, 0, 0, 439, 
+	449, 1, 0, 0, 0, 440, 438, 1, 0, 0, 0, 441, 445, 5, 91, 0, 0, 442, 444, 
+	8, 2, 0, 0, 443, 442, 1, 0, 0, 0, 444, 447, 1, 0, 0, 0, 445, 443, 1, 0, 
+	0, 0, 445, 446, 1, 0, 0, 0, 446, 448, 1, 0, 0, 0, 447, 445, 1, 0, 0, 0, 
+	448, 450, 5, 93, 0, 0, 449, 441, 1, 0, 0, 0, 449, 450, 1, 0, 0, 0, 450, 
+	454, 1, 0, 0, 0, 451, 453, 7, 1, 0, 0, 452, 451, 1, 0, 0, 0, 453, 456, 
+	1, 0, 0, 0, 454, 452, 1, 0, 0, 0, 454, 455, 1, 0, 0, 0, 455, 457, 1, 0, 
+	0, 0, 456, 454, 1, 0, 0, 0, 457, 477, 5, 123, 0, 0, 458, 476, 8, 3, 0, 
+	0, 459, 471, 5, 123, 0, 0, 460, 470, 8, 3, 0, 0, 461, 465, 5, 123, 0, 0, 
+	462, 464, 8, 3, 0, 0, 463, 462, 1, 0, 0, 0, 464, 467, 1, 0, 0, 0, 465, 
+	463, 1, 0, 0, 0, 465, 466, 1, 0, 0, 0, 466, 468, 1, 0, 0, 0, 467, 465, 
+	1, 0, 0, 0, 468, 470, 5, 125, 0, 0, 469, 460, 1, 0, 0, 0, 469, 461, 1, 
+	0, 0, 0, 470, 473, 1, 0, 0, 0, 471, 469, 1, 0, 0, 0, 471, 472, 1, 0, 0, 
+	0, 472, 474, 1, 0, 0, 0, 473, 471, 1, 0, 0, 0, 474, 476, 5, 125, 0, 0, 
+	475, 458, 1, 0, 0, 0, 475, 459, 1, 0, 0, 0, 476, 479, 1, 0, 0, 0, 477, 
+	475, 1, 0, 0, 0, 477, 478, 1, 0, 0, 0, 478, 480, 1, 0, 0, 0, 479, 477, 
+	1, 0, 0, 0, 480, 481, 5, 125, 0, 0, 481, 56, 1, 0, 0, 0, 482, 483, 5, 92, 
+	0, 0, 483, 484, 5, 104, 0, 0, 484, 485, 5, 108, 0, 0, 485, 486, 5, 105, 
+	0, 0, 486, 487, 5, 110, 0, 0, 487, 531, 5, 101, 0, 0, 488, 489, 5, 92, 
+	0, 0, 489, 490, 5, 116, 0, 0, 490, 491, 5, 111, 0, 0, 491, 492, 5, 112, 
+	0, 0, 492, 493, 5, 114, 0, 0, 493, 494, 5, 117, 0, 0, 494, 495, 5, 108, 
+	0, 0, 495, 531, 5, 101, 0, 0, 496, 497, 5, 92, 0, 0, 497, 498, 5, 109, 
+	0, 0, 498, 499, 5, 105, 0, 0, 499, 500, 5, 100, 0, 0, 500, 501, 5, 114, 
+	0, 0, 501, 502, 5, 117, 0, 0, 502, 503, 5, 108, 0, 0, 503, 531, 5, 101, 
+	0, 0, 504, 505, 5, 92, 0, 0, 505, 506, 5, 98, 0, 0, 506, 507, 5, 111, 0, 
+	0, 507, 508, 5, 116, 0, 0, 508, 509, 5, 116, 0, 0, 509, 510, 5, 111, 0, 
+	0, 510, 511, 5, 109, 0, 0, 511, 512, 5, 114, 0, 0, 512, 513, 5, 117, 0, 
+	0, 513, 514, 5, 108, 0, 0, 514, 531, 5, 101, 0, 0, 515, 516, 5, 92, 0, 
+	0, 516, 517, 5, 99, 0, 0, 517, 518, 5, 108, 0, 0, 518, 519, 5, 105, 0, 
+	0, 519, 520, 5, 110, 0, 0, 520, 521, 5, 101, 0, 0, 521, 522, 5, 123, 0, 
+	0, 522, 526, 1, 0, 0, 0, 523, 525, 8, 4, 0, 0, 524, 523, 1, 0, 0, 0, 525, 
+	528, 1, 0, 0, 0, 526, 524, 1, 0, 0, 0, 526, 527, 1, 0, 0, 0, 527, 529, 
+	1, 0, 0, 0, 528, 526, 1, 0, 0, 0, 529, 531, 5, 125, 0, 0, 530, 482, 1, 
+	0, 0, 0, 530, 488, 1, 0, 0, 0, 530, 496, 1, 0, 0, 0, 530, 504, 1, 0, 0, 
+	0, 530, 515, 1, 0, 0, 0, 531, 58, 1, 0, 0, 0, 532, 533, 5, 92, 0, 0, 533, 
+	534, 5, 117, 0, 0, 534, 535, 5, 114, 0, 0, 535, 536, 5, 108, 0, 0, 536, 
+	537, 5, 123, 0, 0, 537, 541, 1, 0, 0, 0, 538, 540, 3, 63, 31, 0, 539, 538, 
+	1, 0, 0, 0, 540, 543, 1, 0, 0, 0, 541, 539, 1, 0, 0, 0, 541, 542, 1, 0, 
+	0, 0, 542, 544, 1, 0, 0, 0, 543, 541, 1, 0, 0, 0, 544, 545, 5, 125, 0, 
+	0, 545, 60, 1, 0, 0, 0, 546, 547, 5, 92, 0, 0, 547, 548, 5, 104, 0, 0, 
+	548, 549, 5, 114, 0, 0, 549, 550, 5, 101, 0, 0, 550, 551, 5, 102, 0, 0, 
+	551, 552, 5, 123, 0, 0, 552, 556, 1, 0, 0, 0, 553, 555, 3, 63, 31, 0, 554, 
+	553, 1, 0, 0, 0, 555, 558, 1, 0, 0, 0, 556, 554, 1, 0, 0, 0, 556, 557, 
+	1, 0, 0, 0, 557, 559, 1, 0, 0, 0, 558, 556, 1, 0, 0, 0, 559, 560, 5, 125, 
+	0, 0, 560, 62, 1, 0, 0, 0, 561, 562, 8, 3, 0, 0, 562, 64, 1, 0, 0, 0, 563, 
+	567, 5, 36, 0, 0, 564, 565, 5, 92, 0, 0, 565, 568, 8, 5, 0, 0, 566, 568, 
+	8, 6, 0, 0, 567, 564, 1, 0, 0, 0, 567, 566, 1, 0, 0, 0, 568, 569, 1, 0, 
+	0, 0, 569, 567, 1, 0, 0, 0, 569, 570, 1, 0, 0, 0, 570, 571, 1, 0, 0, 0, 
+	571, 584, 5, 36, 0, 0, 572, 573, 5, 92, 0, 0, 573, 574, 5, 40, 0, 0, 574, 
+	578, 1, 0, 0, 0, 575, 577, 8, 5, 0, 0, 576, 575, 1, 0, 0, 0, 577, 580, 
+	1, 0, 0, 0, 578, 579, 1, 0, 0, 0, 578, 576, 1, 0, 0, 0, 579, 581, 1, 0, 
+	0, 0, 580, 578, 1, 0, 0, 0, 581, 582, 5, 92, 0, 0, 582, 584, 5, 41, 0, 
+	0, 583, 563, 1, 0, 0, 0, 583, 572, 1, 0, 0, 0, 584, 66, 1, 0, 0, 0, 585, 
+	586, 5, 36, 0, 0, 586, 587, 5, 36, 0, 0, 587, 591, 1, 0, 0, 0, 588, 590, 
+	9, 0, 0, 0, 589, 588, 1, 0, 0, 0, 590, 593, 1, 0, 0, 0, 591, 592, 1, 0, 
+	0, 0, 591, 589, 1, 0, 0, 0, 592, 594, 1, 0, 0, 0, 593, 591, 1, 0, 0, 0, 
+	594, 595, 5, 36, 0, 0, 595, 684, 5, 36, 0, 0, 596, 597, 5, 92, 0, 0, 597, 
+	598, 5, 91, 0, 0, 598, 602, 1, 0, 0, 0, 599, 601, 9, 0, 0, 0, 600, 599, 
+	1, 0, 0, 0, 601, 604, 1, 0, 0, 0, 602, 603, 1, 0, 0, 0, 602, 600, 1, 0, 
+	0, 0, 603, 605, 1, 0, 0, 0, 604, 602, 1, 0, 0, 0, 605, 606, 5, 92, 0, 0, 
+	606, 684, 5, 93, 0, 0, 607, 608, 5, 92, 0, 0, 608, 609, 5, 98, 0, 0, 609, 
+	610, 5, 101, 0, 0, 610, 611, 5, 103, 0, 0, 611, 612, 5, 105, 0, 0, 612, 
+	613, 5, 110, 0, 0, 613, 614, 5, 123, 0, 0, 614, 615, 5, 101, 0, 0, 615, 
+	616, 5, 113, 0, 0, 616, 617, 5, 117, 0, 0, 617, 618, 5, 97, 0, 0, 618, 
+	619, 5, 116, 0, 0, 619, 620, 5, 105, 0, 0, 620, 621, 5, 111, 0, 0, 621, 
+	622, 5, 110, 0, 0, 622, 623, 5, 125, 0, 0, 623, 627, 1, 0, 0, 0, 624, 626, 
+	9, 0, 0, 0, 625, 624, 1, 0, 0, 0, 626, 629, 1, 0, 0, 0, 627, 628, 1, 0, 
+	0, 0, 627, 625, 1, 0, 0, 0, 628, 630, 1, 0, 0, 0, 629, 627, 1, 0, 0, 0, 
+	630, 631, 5, 92, 0, 0, 631, 632, 5, 101, 0, 0, 632, 633, 5, 110, 0, 0, 
+	633, 634, 5, 100, 0, 0, 634, 635, 5, 123, 0, 0, 635, 636, 5, 101, 0, 0, 
+	636, 637, 5, 113, 0, 0, 637, 638, 5, 117, 0, 0, 638, 639, 5, 97, 0, 0, 
+	639, 640, 5, 116, 0, 0, 640, 641, 5, 105, 0, 0, 641, 642, 5, 111, 0, 0, 
+	642, 643, 5, 110, 0, 0, 643, 684, 5, 125, 0, 0, 644, 645, 5, 92, 0, 0, 
+	645, 646, 5, 98, 0, 0, 646, 647, 5, 101, 0, 0, 647, 648, 5, 103, 0, 0, 
+	648, 649, 5, 105, 0, 0, 649, 650, 5, 110, 0, 0, 650, 651, 5, 123, 0, 0, 
+	651, 652, 5, 101, 0, 0, 652, 653, 5, 113, 0, 0, 653, 654, 5, 117, 0, 0, 
+	654, 655, 5, 97, 0, 0, 655, 656, 5, 116, 0, 0, 656, 657, 5, 105, 0, 0, 
+	657, 658, 5, 111, 0, 0, 658, 659, 5, 110, 0, 0, 659, 660, 5, 42, 0, 0, 
+	660, 661, 5, 125, 0, 0, 661, 665, 1, 0, 0, 0, 662, 664, 9, 0, 0, 0, 663, 
+	662, 1, 0, 0, 0, 664, 667, 1, 0, 0, 0, 665, 666, 1, 0, 0, 0, 665, 663, 
+	1, 0, 0, 0, 666, 668, 1, 0, 0, 0, 667, 665, 1, 0, 0, 0, 668, 669, 5, 92, 
+	0, 0, 669, 670, 5, 101, 0, 0, 670, 671, 5, 110, 0, 0, 671, 672, 5, 100, 
+	0, 0, 672, 673, 5, 123, 0, 0, 673, 674, 5, 101, 0, 0, 674, 675, 5, 113, 
+	0, 0, 675, 676, 5, 117, 0, 0, 676, 677, 5, 97, 0, 0, 677, 678, 5, 116, 
+	0, 0, 678, 679, 5, 105, 0, 0, 679, 680, 5, 111, 0, 0, 680, 681, 5, 110, 
+	0, 0, 681, 682, 5, 42, 0, 0, 682, 684, 5, 125, 0, 0, 683, 585, 1, 0, 0, 
+	0, 683, 596, 1, 0, 0, 0, 683, 607, 1, 0, 0, 0, 683, 644, 1, 0, 0, 0, 684, 
+	68, 1, 0, 0, 0, 685, 686, 5, 92, 0, 0, 686, 687, 5, 36, 0, 0, 687, 70, 
+	1, 0, 0, 0, 688, 690, 7, 7, 0, 0, 689, 688, 1, 0, 0, 0, 690, 691, 1, 0, 
+	0, 0, 691, 689, 1, 0, 0, 0, 691, 692, 1, 0, 0, 0, 692, 72, 1, 0, 0, 0, 
+	693, 695, 7, 8, 0, 0, 694, 693, 1, 0, 0, 0, 695, 696, 1, 0, 0, 0, 696, 
+	694, 1, 0, 0, 0, 696, 697, 1, 0, 0, 0, 697, 74, 1, 0, 0, 0, 698, 699, 5, 
+	38, 0, 0, 699, 76, 1, 0, 0, 0, 700, 701, 7, 9, 0, 0, 701, 78, 1, 0, 0, 
+	0, 702, 704, 5, 45, 0, 0, 703, 702, 1, 0, 0, 0, 703, 704, 1, 0, 0, 0, 704, 
+	705, 1, 0, 0, 0, 705, 712, 3, 81, 40, 0, 706, 708, 5, 46, 0, 0, 707, 709, 
+	7, 10, 0, 0, 708, 707, 1, 0, 0, 0, 709, 710, 1, 0, 0, 0, 710, 708, 1, 0, 
+	0, 0, 710, 711, 1, 0, 0, 0, 711, 713, 1, 0, 0, 0, 712, 706, 1, 0, 0, 0, 
+	712, 713, 1, 0, 0, 0, 713, 80, 1, 0, 0, 0, 714, 723, 5, 48, 0, 0, 715, 
+	719, 7, 11, 0, 0, 716, 718, 7, 10, 0, 0, 717, 716, 1, 0, 0, 0, 718, 721, 
+	1, 0, 0, 0, 719, 717, 1, 0, 0, 0, 719, 720, 1, 0, 0, 0, 720, 723, 1, 0, 
+	0, 0, 721, 719, 1, 0, 0, 0, 722, 714, 1, 0, 0, 0, 722, 715, 1, 0, 0, 0, 
+	723, 82, 1, 0, 0, 0, 724, 725, 5, 10, 0, 0, 725, 84, 1, 0, 0, 0, 726, 728, 
+	7, 1, 0, 0, 727, 726, 1, 0, 0, 0, 728, 729, 1, 0, 0, 0, 729, 727, 1, 0, 
+	0, 0, 729, 730, 1, 0, 0, 0, 730, 86, 1, 0, 0, 0, 731, 732, 5, 13, 0, 0, 
+	732, 733, 1, 0, 0, 0, 733, 734, 6, 43, 0, 0, 734, 88, 1, 0, 0, 0, 32, 0, 
+	414, 438, 445, 449, 454, 465, 469, 471, 475, 477, 526, 530, 541, 556, 567, 
+	569, 578, 583, 591, 602, 627, 665, 683, 691, 696, 703, 710, 712, 719, 722, 
+	729, 1, 6, 0, 0,
 }
   deserializer := antlr.NewATNDeserializer(nil)
   staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -354,12 +570,32 @@ const (
 	LatexLexerT__12 = 13
 	LatexLexerT__13 = 14
 	LatexLexerT__14 = 15
-	LatexLexerLETTER = 16
-	LatexLexerPUNCTUATION = 17
-	LatexLexerSYMBOL = 18
-	LatexLexerNUMBER = 19
-	LatexLexerNEWLINE = 20
-	LatexLexerWS = 21
-	LatexLexerCR = 22
+	LatexLexerT__15 = 16
+	LatexLexerT__16 = 17
+	LatexLexerT__17 = 18
+	LatexLexerT__18 = 19
+	LatexLexerT__19 = 20
+	LatexLexerT__20 = 21
+	LatexLexerT__21 = 22
+	LatexLexerT__22 = 23
+	LatexLexerT__23 = 24
+	LatexLexerT__24 = 25
+	LatexLexerT__25 = 26
+	LatexLexerMETADATA_KEY = 27
+	LatexLexerTABULAR = 28
+	LatexLexerTABLE_RULE = 29
+	LatexLexerURL = 30
+	LatexLexerHREF = 31
+	LatexLexerINLINE_MATH = 32
+	LatexLexerDISPLAY_MATH = 33
+	LatexLexerDOLLAR = 34
+	LatexLexerLETTER = 35
+	LatexLexerPUNCTUATION = 36
+	LatexLexerAMPERSAND = 37
+	LatexLexerSYMBOL = 38
+	LatexLexerNUMBER = 39
+	LatexLexerNEWLINE = 40
+	LatexLexerWS = 41
+	LatexLexerCR = 42
 )
 
@@ -11,6 +11,9 @@ type LatexListener interface {
 	// EnterLatex is called when entering the latex production.
 	EnterLatex(c *LatexContext)
 
+	// EnterHeader is called when entering the header production.
+	EnterHeader(c *HeaderContext)
+
 	// EnterNote_title is called when entering the note_title production.
 	EnterNote_title(c *Note_titleContext)
 
@@ -23,6 +26,9 @@ type LatexListener interface {
 	// EnterNote_updated is called when entering the note_updated production.
 	EnterNote_updated(c *Note_updatedContext)
 
+	// EnterNote_metadata is called when entering the note_metadata production.
+	EnterNote_metadata(c *Note_metadataContext)
+
 	// EnterNote_text is called when entering the note_text production.
 	EnterNote_text(c *Note_textContext)
 
@@ -41,9 +47,33 @@ type LatexListener interface {
 	// EnterTag is called when entering the tag production.
 	EnterTag(c *TagContext)
 
+	// EnterFootnote is called when entering the footnote production.
+	EnterFootnote(c *FootnoteContext)
+
+	// EnterCommand is called when entering the command production.
+	EnterCommand(c *CommandContext)
+
+	// EnterHref is called when entering the href production.
+	EnterHref(c *HrefContext)
+
+	// EnterUrl is called when entering the url production.
+	EnterUrl(c *UrlContext)
+
+	// EnterInline_math is called when entering the inline_math production.
+	EnterInline_math(c *Inline_mathContext)
+
+	// EnterDisplay_math is called when entering the display_math production.
+	EnterDisplay_math(c *Display_mathContext)
+
 	// EnterEscaped is called when entering the escaped production.
 	EnterEscaped(c *EscapedContext)
 
+	// EnterDollar is called when entering the dollar production.
+	EnterDollar(c *DollarContext)
+
+	// EnterLabel is called when entering the label production.
+	EnterLabel(c *LabelContext)
+
 	// EnterLetter is called when entering the letter production.
 	EnterLetter(c *LetterContext)
 
@@ -68,9 +98,6 @@ type LatexListener interface {
 	// EnterVerbatim_line is called when entering the verbatim_line production.
 	EnterVerbatim_line(c *Verbatim_lineContext)
 
-	// EnterBlock_line is called when entering the block_line production.
-	EnterBlock_line(c *Block_lineContext)
-
 	// EnterBlock_item is called when entering the block_item production.
 	EnterBlock_item(c *Block_itemContext)
 
@@ -80,12 +107,30 @@ type LatexListener interface {
 	// EnterEnumerate is called when entering the enumerate production.
 	EnterEnumerate(c *EnumerateContext)
 
+	// EnterQuote is called when entering the quote production.
+	EnterQuote(c *QuoteContext)
+
+	// EnterQuotation is called when entering the quotation production.
+	EnterQuotation(c *QuotationContext)
+
 	// EnterVerbatim is called when entering the verbatim production.
 	EnterVerbatim(c *VerbatimContext)
 
+	// EnterTabular is called when entering the tabular production.
+	EnterTabular(c *TabularContext)
+
+	// EnterTable_row is called when entering the table_row production.
+	EnterTable_row(c *Table_rowContext)
+
+	// EnterTable_cell is called when entering the table_cell production.
+	EnterTable_cell(c *Table_cellContext)
+
 	// ExitLatex is called when exiting the latex production.
 	ExitLatex(c *LatexContext)
 
+	// ExitHeader is called when exiting the header production.
+	ExitHeader(c *HeaderContext)
+
 	// ExitNote_title is called when exiting the note_title production.
 	ExitNote_title(c *Note_titleContext)
 
@@ -98,6 +143,9 @@ type LatexListener interface {
 	// ExitNote_updated is called when exiting the note_updated production.
 	ExitNote_updated(c *Note_updatedContext)
 
+	// ExitNote_metadata is called when exiting the note_metadata production.
+	ExitNote_metadata(c *Note_metadataContext)
+
 	// ExitNote_text is called when exiting the note_text production.
 	ExitNote_text(c *Note_textContext)
 
@@ -116,9 +164,33 @@ type LatexListener interface {
 	// ExitTag is called when exiting the tag production.
 	ExitTag(c *TagContext)
 
+	// ExitFootnote is called when exiting the footnote production.
+	ExitFootnote(c *FootnoteContext)
+
+	// ExitCommand is called when exiting the command production.
+	ExitCommand(c *CommandContext)
+
+	// ExitHref is called when exiting the href production.
+	ExitHref(c *HrefContext)
+
+	// ExitUrl is called when exiting the url production.
+	ExitUrl(c *UrlContext)
+
+	// ExitInline_math is called when exiting the inline_math production.
+	ExitInline_math(c *Inline_mathContext)
+
+	// ExitDisplay_math is called when exiting the display_math production.
+	ExitDisplay_math(c *Display_mathContext)
+
 	// ExitEscaped is called when exiting the escaped production.
 	ExitEscaped(c *EscapedContext)
 
+	// ExitDollar is called when exiting the dollar production.
+	ExitDollar(c *DollarContext)
+
+	// ExitLabel is called when exiting the label production.
+	ExitLabel(c *LabelContext)
+
 	// ExitLetter is called when exiting the letter production.
 	ExitLetter(c *LetterContext)
 
@@ -143,9 +215,6 @@ type LatexListener interface {
 	// ExitVerbatim_line is called when exiting the verbatim_line production.
 	ExitVerbatim_line(c *Verbatim_lineContext)
 
-	// ExitBlock_line is called when exiting the block_line production.
-	ExitBlock_line(c *Block_lineContext)
-
 	// ExitBlock_item is called when exiting the block_item production.
 	ExitBlock_item(c *Block_itemContext)
 
@@ -155,6 +224,21 @@ type LatexListener interface {
 	// ExitEnumerate is called when exiting the enumerate production.
 	ExitEnumerate(c *EnumerateContext)
 
+	// ExitQuote is called when exiting the quote production.
+	ExitQuote(c *QuoteContext)
+
+	// ExitQuotation is called when exiting the quotation production.
+	ExitQuotation(c *QuotationContext)
+
 	// ExitVerbatim is called when exiting the verbatim production.
 	ExitVerbatim(c *VerbatimContext)
+
+	// ExitTabular is called when exiting the tabular production.
+	ExitTabular(c *TabularContext)
+
+	// ExitTable_row is called when exiting the table_row production.
+	ExitTable_row(c *Table_rowContext)
+
+	// ExitTable_cell is called when exiting the table_cell production.
+	ExitTable_cell(c *Table_cellContext)
 }
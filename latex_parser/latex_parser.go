@@ -33,140 +33,235 @@ var LatexParserStaticData struct {
 func latexParserInit() {
   staticData := &LatexParserStaticData
   staticData.LiteralNames = []string{
-    "", "'\\textbf{Title:}'", "'\\\\'", "'\\textbf{URL:} \\url{'", "'}'", 
-    "'\\textbf{Created:}'", "'\\textbf{Last Updated:}'", "'\\'", "'{'", 
-    "'\\item'", "'\\begin{itemize}'", "'\\end{itemize}'", "'\\begin{enumerate}'", 
-    "'\\end{enumerate}'", "'\\begin{verbatim}'", "'\\end{verbatim}'", "", 
-    "", "", "", "'\\n'", "", "'\\r'",
+    "", "'\\textbf{Title:}'", "'\\\\'", "'\\textbf{URL:}'", "'\\textbf{Created:}'", 
+    "'\\textbf{Last Updated:}'", "'\\'", "'\\textbf{'", "'\\emph{'", "'\\textit{'", 
+    "'\\texttt{'", "'\\underline{'", "'}'", "'\\footnote{'", "'{'", "'\\item'", 
+    "'\\begin{itemize}'", "'\\end{itemize}'", "'\\begin{enumerate}'", "'\\end{enumerate}'", 
+    "'\\begin{quote}'", "'\\end{quote}'", "'\\begin{quotation}'", "'\\end{quotation}'", 
+    "'\\begin{verbatim}'", "'\\end{verbatim}'", "'\\end{tabular}'", "", 
+    "", "", "", "", "", "", "'\\$'", "", "", "'&'", "", "", "'\\n'", "", 
+    "'\\r'",
   }
   staticData.SymbolicNames = []string{
-    "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "LETTER", 
-    "PUNCTUATION", "SYMBOL", "NUMBER", "NEWLINE", "WS", "CR",
+    "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
+    "", "", "", "", "", "", "", "", "", "", "METADATA_KEY", "TABULAR", "TABLE_RULE", 
+    "URL", "HREF", "INLINE_MATH", "DISPLAY_MATH", "DOLLAR", "LETTER", "PUNCTUATION", 
+    "AMPERSAND", "SYMBOL", "NUMBER", "NEWLINE", "WS", "CR",
   }
   staticData.RuleNames = []string{
-    "latex", "note_title", "note_url", "note_created", "note_updated", "note_text", 
-    "text", "line_break", "empty_line", "escaped_word", "tag", "word", "verbatim_content", 
-    "verbatim_line", "block_line", "block_item", "block",
+    "latex", "header", "note_title", "note_url", "note_created", "note_updated", 
+    "note_metadata", "note_text", "text", "line_break", "empty_line", "escaped_word", 
+    "tag", "footnote", "command", "href", "url", "math", "word", "verbatim_content", 
+    "verbatim_line", "block_item", "block", "table_row", "table_cell",
   }
   staticData.PredictionContextCache = antlr.NewPredictionContextCache()
   staticData.serializedATN = []int32{
-	4, 1, 22, 256, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7, 
+	4, 1, 42, 422, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7, 
 	4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7, 
 	10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15, 
-	2, 16, 7, 16, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 5, 0, 41, 8, 0, 10, 0, 
-	12, 0, 44, 9, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 5, 1, 51, 8, 1, 10, 1, 12, 
-	1, 54, 9, 1, 1, 1, 4, 1, 57, 8, 1, 11, 1, 12, 1, 58, 1, 1, 1, 1, 3, 1, 
-	63, 8, 1, 1, 2, 1, 2, 4, 2, 67, 8, 2, 11, 2, 12, 2, 68, 1, 2, 1, 2, 1, 
-	2, 3, 2, 74, 8, 2, 1, 3, 1, 3, 5, 3, 78, 8, 3, 10, 3, 12, 3, 81, 9, 3, 
-	1, 3, 4, 3, 84, 8, 3, 11, 3, 12, 3, 85, 1, 3, 1, 3, 3, 3, 90, 8, 3, 1, 
-	4, 1, 4, 5, 4, 94, 8, 4, 10, 4, 12, 4, 97, 9, 4, 1, 4, 4, 4, 100, 8, 4, 
-	11, 4, 12, 4, 101, 1, 4, 1, 4, 3, 4, 106, 8, 4, 1, 5, 1, 5, 1, 5, 1, 5, 
-	5, 5, 112, 8, 5, 10, 5, 12, 5, 115, 9, 5, 1, 6, 1, 6, 4, 6, 119, 8, 6, 
-	11, 6, 12, 6, 120, 1, 6, 3, 6, 124, 8, 6, 1, 7, 1, 7, 5, 7, 128, 8, 7, 
-	10, 7, 12, 7, 131, 9, 7, 1, 8, 4, 8, 134, 8, 8, 11, 8, 12, 8, 135, 1, 9, 
-	1, 9, 4, 9, 140, 8, 9, 11, 9, 12, 9, 141, 1, 9, 5, 9, 145, 8, 9, 10, 9, 
-	12, 9, 148, 9, 9, 1, 9, 3, 9, 151, 8, 9, 1, 10, 1, 10, 4, 10, 155, 8, 10, 
-	11, 10, 12, 10, 156, 1, 10, 1, 10, 4, 10, 161, 8, 10, 11, 10, 12, 10, 162, 
-	1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 3, 11, 172, 8, 11, 1, 
-	12, 1, 12, 1, 12, 3, 12, 177, 8, 12, 1, 13, 5, 13, 180, 8, 13, 10, 13, 
-	12, 13, 183, 9, 13, 1, 13, 1, 13, 1, 14, 4, 14, 188, 8, 14, 11, 14, 12, 
-	14, 189, 1, 14, 4, 14, 193, 8, 14, 11, 14, 12, 14, 194, 1, 15, 1, 15, 5, 
-	15, 199, 8, 15, 10, 15, 12, 15, 202, 9, 15, 1, 15, 1, 15, 1, 16, 1, 16, 
-	5, 16, 208, 8, 16, 10, 16, 12, 16, 211, 9, 16, 1, 16, 5, 16, 214, 8, 16, 
-	10, 16, 12, 16, 217, 9, 16, 1, 16, 1, 16, 3, 16, 221, 8, 16, 1, 16, 1, 
-	16, 5, 16, 225, 8, 16, 10, 16, 12, 16, 228, 9, 16, 1, 16, 5, 16, 231, 8, 
-	16, 10, 16, 12, 16, 234, 9, 16, 1, 16, 1, 16, 3, 16, 238, 8, 16, 1, 16, 
-	1, 16, 3, 16, 242, 8, 16, 1, 16, 5, 16, 245, 8, 16, 10, 16, 12, 16, 248, 
-	9, 16, 1, 16, 1, 16, 3, 16, 252, 8, 16, 3, 16, 254, 8, 16, 1, 16, 0, 0, 
-	17, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 0, 1, 
-	2, 0, 16, 16, 18, 18, 285, 0, 34, 1, 0, 0, 0, 2, 48, 1, 0, 0, 0, 4, 64, 
-	1, 0, 0, 0, 6, 75, 1, 0, 0, 0, 8, 91, 1, 0, 0, 0, 10, 113, 1, 0, 0, 0, 
-	12, 118, 1, 0, 0, 0, 14, 125, 1, 0, 0, 0, 16, 133, 1, 0, 0, 0, 18, 137, 
-	1, 0, 0, 0, 20, 152, 1, 0, 0, 0, 22, 171, 1, 0, 0, 0, 24, 176, 1, 0, 0, 
-	0, 26, 181, 1, 0, 0, 0, 28, 187, 1, 0, 0, 0, 30, 196, 1, 0, 0, 0, 32, 253, 
-	1, 0, 0, 0, 34, 35, 3, 2, 1, 0, 35, 36, 3, 4, 2, 0, 36, 37, 3, 6, 3, 0, 
-	37, 38, 3, 8, 4, 0, 38, 42, 3, 14, 7, 0, 39, 41, 5, 20, 0, 0, 40, 39, 1, 
-	0, 0, 0, 41, 44, 1, 0, 0, 0, 42, 40, 1, 0, 0, 0, 42, 43, 1, 0, 0, 0, 43, 
-	45, 1, 0, 0, 0, 44, 42, 1, 0, 0, 0, 45, 46, 3, 10, 5, 0, 46, 47, 5, 0, 
-	0, 1, 47, 1, 1, 0, 0, 0, 48, 52, 5, 1, 0, 0, 49, 51, 5, 21, 0, 0, 50, 49, 
-	1, 0, 0, 0, 51, 54, 1, 0, 0, 0, 52, 50, 1, 0, 0, 0, 52, 53, 1, 0, 0, 0, 
-	53, 56, 1, 0, 0, 0, 54, 52, 1, 0, 0, 0, 55, 57, 3, 22, 11, 0, 56, 55, 1, 
-	0, 0, 0, 57, 58, 1, 0, 0, 0, 58, 56, 1, 0, 0, 0, 58, 59, 1, 0, 0, 0, 59, 
-	60, 1, 0, 0, 0, 60, 62, 5, 2, 0, 0, 61, 63, 5, 20, 0, 0, 62, 61, 1, 0, 
-	0, 0, 62, 63, 1, 0, 0, 0, 63, 3, 1, 0, 0, 0, 64, 66, 5, 3, 0, 0, 65, 67, 
-	3, 22, 11, 0, 66, 65, 1, 0, 0, 0, 67, 68, 1, 0, 0, 0, 68, 66, 1, 0, 0, 
-	0, 68, 69, 1, 0, 0, 0, 69, 70, 1, 0, 0, 0, 70, 71, 5, 4, 0, 0, 71, 73, 
-	5, 2, 0, 0, 72, 74, 5, 20, 0, 0, 73, 72, 1, 0, 0, 0, 73, 74, 1, 0, 0, 0, 
-	74, 5, 1, 0, 0, 0, 75, 79, 5, 5, 0, 0, 76, 78, 5, 21, 0, 0, 77, 76, 1, 
-	0, 0, 0, 78, 81, 1, 0, 0, 0, 79, 77, 1, 0, 0, 0, 79, 80, 1, 0, 0, 0, 80, 
-	83, 1, 0, 0, 0, 81, 79, 1, 0, 0, 0, 82, 84, 3, 22, 11, 0, 83, 82, 1, 0, 
-	0, 0, 84, 85, 1, 0, 0, 0, 85, 83, 1, 0, 0, 0, 85, 86, 1, 0, 0, 0, 86, 87, 
-	1, 0, 0, 0, 87, 89, 5, 2, 0, 0, 88, 90, 5, 20, 0, 0, 89, 88, 1, 0, 0, 0, 
-	89, 90, 1, 0, 0, 0, 90, 7, 1, 0, 0, 0, 91, 95, 5, 6, 0, 0, 92, 94, 5, 21, 
-	0, 0, 93, 92, 1, 0, 0, 0, 94, 97, 1, 0, 0, 0, 95, 93, 1, 0, 0, 0, 95, 96, 
-	1, 0, 0, 0, 96, 99, 1, 0, 0, 0, 97, 95, 1, 0, 0, 0, 98, 100, 3, 22, 11, 
-	0, 99, 98, 1, 0, 0, 0, 100, 101, 1, 0, 0, 0, 101, 99, 1, 0, 0, 0, 101, 
-	102, 1, 0, 0, 0, 102, 103, 1, 0, 0, 0, 103, 105, 5, 2, 0, 0, 104, 106, 
-	5, 20, 0, 0, 105, 104, 1, 0, 0, 0, 105, 106, 1, 0, 0, 0, 106, 9, 1, 0, 
-	0, 0, 107, 112, 3, 12, 6, 0, 108, 112, 3, 32, 16, 0, 109, 112, 3, 14, 7, 
-	0, 110, 112, 3, 16, 8, 0, 111, 107, 1, 0, 0, 0, 111, 108, 1, 0, 0, 0, 111, 
-	109, 1, 0, 0, 0, 111, 110, 1, 0, 0, 0, 112, 115, 1, 0, 0, 0, 113, 111, 
-	1, 0, 0, 0, 113, 114, 1, 0, 0, 0, 114, 11, 1, 0, 0, 0, 115, 113, 1, 0, 
-	0, 0, 116, 119, 3, 20, 10, 0, 117, 119, 3, 22, 11, 0, 118, 116, 1, 0, 0, 
-	0, 118, 117, 1, 0, 0, 0, 119, 120, 1, 0, 0, 0, 120, 118, 1, 0, 0, 0, 120, 
-	121, 1, 0, 0, 0, 121, 123, 1, 0, 0, 0, 122, 124, 5, 20, 0, 0, 123, 122, 
-	1, 0, 0, 0, 123, 124, 1, 0, 0, 0, 124, 13, 1, 0, 0, 0, 125, 129, 5, 2, 
-	0, 0, 126, 128, 5, 21, 0, 0, 127, 126, 1, 0, 0, 0, 128, 131, 1, 0, 0, 0, 
-	129, 127, 1, 0, 0, 0, 129, 130, 1, 0, 0, 0, 130, 15, 1, 0, 0, 0, 131, 129, 
-	1, 0, 0, 0, 132, 134, 5, 20, 0, 0, 133, 132, 1, 0, 0, 0, 134, 135, 1, 0, 
-	0, 0, 135, 133, 1, 0, 0, 0, 135, 136, 1, 0, 0, 0, 136, 17, 1, 0, 0, 0, 
-	137, 139, 5, 7, 0, 0, 138, 140, 7, 0, 0, 0, 139, 138, 1, 0, 0, 0, 140, 
-	141, 1, 0, 0, 0, 141, 139, 1, 0, 0, 0, 141, 142, 1, 0, 0, 0, 142, 146, 
-	1, 0, 0, 0, 143, 145, 5, 21, 0, 0, 144, 143, 1, 0, 0, 0, 145, 148, 1, 0, 
-	0, 0, 146, 144, 1, 0, 0, 0, 146, 147, 1, 0, 0, 0, 147, 150, 1, 0, 0, 0, 
-	148, 146, 1, 0, 0, 0, 149, 151, 5, 20, 0, 0, 150, 149, 1, 0, 0, 0, 150, 
-	151, 1, 0, 0, 0, 151, 19, 1, 0, 0, 0, 152, 154, 5, 7, 0, 0, 153, 155, 5, 
-	16, 0, 0, 154, 153, 1, 0, 0, 0, 155, 156, 1, 0, 0, 0, 156, 154, 1, 0, 0, 
-	0, 156, 157, 1, 0, 0, 0, 157, 158, 1, 0, 0, 0, 158, 160, 5, 8, 0, 0, 159, 
-	161, 3, 22, 11, 0, 160, 159, 1, 0, 0, 0, 161, 162, 1, 0, 0, 0, 162, 160, 
-	1, 0, 0, 0, 162, 163, 1, 0, 0, 0, 163, 164, 1, 0, 0, 0, 164, 165, 5, 4, 
-	0, 0, 165, 21, 1, 0, 0, 0, 166, 172, 3, 18, 9, 0, 167, 172, 5, 16, 0, 0, 
-	168, 172, 5, 17, 0, 0, 169, 172, 5, 19, 0, 0, 170, 172, 5, 21, 0, 0, 171, 
-	166, 1, 0, 0, 0, 171, 167, 1, 0, 0, 0, 171, 168, 1, 0, 0, 0, 171, 169, 
-	1, 0, 0, 0, 171, 170, 1, 0, 0, 0, 172, 23, 1, 0, 0, 0, 173, 177, 3, 22, 
-	11, 0, 174, 177, 5, 18, 0, 0, 175, 177, 3, 14, 7, 0, 176, 173, 1, 0, 0, 
-	0, 176, 174, 1, 0, 0, 0, 176, 175, 1, 0, 0, 0, 177, 25, 1, 0, 0, 0, 178, 
-	180, 3, 24, 12, 0, 179, 178, 1, 0, 0, 0, 180, 183, 1, 0, 0, 0, 181, 179, 
-	1, 0, 0, 0, 181, 182, 1, 0, 0, 0, 182, 184, 1, 0, 0, 0, 183, 181, 1, 0, 
-	0, 0, 184, 185, 5, 20, 0, 0, 185, 27, 1, 0, 0, 0, 186, 188, 3, 22, 11, 
-	0, 187, 186, 1, 0, 0, 0, 188, 189, 1, 0, 0, 0, 189, 187, 1, 0, 0, 0, 189, 
-	190, 1, 0, 0, 0, 190, 192, 1, 0, 0, 0, 191, 193, 5, 20, 0, 0, 192, 191, 
-	1, 0, 0, 0, 193, 194, 1, 0, 0, 0, 194, 192, 1, 0, 0, 0, 194, 195, 1, 0, 
-	0, 0, 195, 29, 1, 0, 0, 0, 196, 200, 5, 9, 0, 0, 197, 199, 5, 21, 0, 0, 
-	198, 197, 1, 0, 0, 0, 199, 202, 1, 0, 0, 0, 200, 198, 1, 0, 0, 0, 200, 
-	201, 1, 0, 0, 0, 201, 203, 1, 0, 0, 0, 202, 200, 1, 0, 0, 0, 203, 204, 
-	3, 28, 14, 0, 204, 31, 1, 0, 0, 0, 205, 209, 5, 10, 0, 0, 206, 208, 5, 
-	20, 0, 0, 207, 206, 1, 0, 0, 0, 208, 211, 1, 0, 0, 0, 209, 207, 1, 0, 0, 
-	0, 209, 210, 1, 0, 0, 0, 210, 215, 1, 0, 0, 0, 211, 209, 1, 0, 0, 0, 212, 
-	214, 3, 30, 15, 0, 213, 212, 1, 0, 0, 0, 214, 217, 1, 0, 0, 0, 215, 213, 
-	1, 0, 0, 0, 215, 216, 1, 0, 0, 0, 216, 218, 1, 0, 0, 0, 217, 215, 1, 0, 
-	0, 0, 218, 220, 5, 11, 0, 0, 219, 221, 5, 20, 0, 0, 220, 219, 1, 0, 0, 
-	0, 220, 221, 1, 0, 0, 0, 221, 254, 1, 0, 0, 0, 222, 226, 5, 12, 0, 0, 223, 
-	225, 5, 20, 0, 0, 224, 223, 1, 0, 0, 0, 225, 228, 1, 0, 0, 0, 226, 224, 
-	1, 0, 0, 0, 226, 227, 1, 0, 0, 0, 227, 232, 1, 0, 0, 0, 228, 226, 1, 0, 
-	0, 0, 229, 231, 3, 30, 15, 0, 230, 229, 1, 0, 0, 0, 231, 234, 1, 0, 0, 
-	0, 232, 230, 1, 0, 0, 0, 232, 233, 1, 0, 0, 0, 233, 235, 1, 0, 0, 0, 234, 
-	232, 1, 0, 0, 0, 235, 237, 5, 13, 0, 0, 236, 238, 5, 20, 0, 0, 237, 236, 
-	1, 0, 0, 0, 237, 238, 1, 0, 0, 0, 238, 254, 1, 0, 0, 0, 239, 241, 5, 14, 
-	0, 0, 240, 242, 5, 20, 0, 0, 241, 240, 1, 0, 0, 0, 241, 242, 1, 0, 0, 0, 
-	242, 246, 1, 0, 0, 0, 243, 245, 3, 26, 13, 0, 244, 243, 1, 0, 0, 0, 245, 
-	248, 1, 0, 0, 0, 246, 244, 1, 0, 0, 0, 246, 247, 1, 0, 0, 0, 247, 249, 
-	1, 0, 0, 0, 248, 246, 1, 0, 0, 0, 249, 251, 5, 15, 0, 0, 250, 252, 5, 20, 
-	0, 0, 251, 250, 1, 0, 0, 0, 251, 252, 1, 0, 0, 0, 252, 254, 1, 0, 0, 0, 
-	253, 205, 1, 0, 0, 0, 253, 222, 1, 0, 0, 0, 253, 239, 1, 0, 0, 0, 254, 
-	33, 1, 0, 0, 0, 40, 42, 52, 58, 62, 68, 73, 79, 85, 89, 95, 101, 105, 111, 
-	113, 118, 120, 123, 129, 135, 141, 146, 150, 156, 162, 171, 176, 181, 189, 
-	194, 200, 209, 215, 220, 226, 232, 237, 241, 246, 251, 253,
+	2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2, 
+	21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 1, 0, 1, 0, 1, 0, 
+	5, 0, 54, 8, 0, 10, 0, 12, 0, 57, 9, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 
+	1, 1, 1, 1, 1, 4, 1, 67, 8, 1, 11, 1, 12, 1, 68, 1, 2, 1, 2, 5, 2, 73, 
+	8, 2, 10, 2, 12, 2, 76, 9, 2, 1, 2, 4, 2, 79, 8, 2, 11, 2, 12, 2, 80, 1, 
+	2, 1, 2, 3, 2, 85, 8, 2, 1, 3, 1, 3, 5, 3, 89, 8, 3, 10, 3, 12, 3, 92, 
+	9, 3, 1, 3, 1, 3, 1, 3, 3, 3, 97, 8, 3, 1, 4, 1, 4, 5, 4, 101, 8, 4, 10, 
+	4, 12, 4, 104, 9, 4, 1, 4, 4, 4, 107, 8, 4, 11, 4, 12, 4, 108, 1, 4, 1, 
+	4, 3, 4, 113, 8, 4, 1, 5, 1, 5, 5, 5, 117, 8, 5, 10, 5, 12, 5, 120, 9, 
+	5, 1, 5, 4, 5, 123, 8, 5, 11, 5, 12, 5, 124, 1, 5, 1, 5, 3, 5, 129, 8, 
+	5, 1, 6, 1, 6, 5, 6, 133, 8, 6, 10, 6, 12, 6, 136, 9, 6, 1, 6, 1, 6, 1, 
+	6, 1, 6, 1, 6, 1, 6, 5, 6, 144, 8, 6, 10, 6, 12, 6, 147, 9, 6, 1, 6, 1, 
+	6, 3, 6, 151, 8, 6, 1, 7, 1, 7, 1, 7, 1, 7, 5, 7, 157, 8, 7, 10, 7, 12, 
+	7, 160, 9, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 4, 8, 169, 8, 8, 
+	11, 8, 12, 8, 170, 1, 8, 3, 8, 174, 8, 8, 1, 9, 1, 9, 5, 9, 178, 8, 9, 
+	10, 9, 12, 9, 181, 9, 9, 1, 10, 4, 10, 184, 8, 10, 11, 10, 12, 10, 185, 
+	1, 11, 1, 11, 4, 11, 190, 8, 11, 11, 11, 12, 11, 191, 1, 11, 5, 11, 195, 
+	8, 11, 10, 11, 12, 11, 198, 9, 11, 1, 11, 3, 11, 201, 8, 11, 1, 12, 1, 
+	12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 5, 12, 212, 8, 12, 
+	10, 12, 12, 12, 215, 9, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 
+	13, 1, 13, 1, 13, 1, 13, 5, 13, 227, 8, 13, 10, 13, 12, 13, 230, 9, 13, 
+	1, 13, 1, 13, 1, 14, 1, 14, 4, 14, 236, 8, 14, 11, 14, 12, 14, 237, 1, 
+	14, 1, 14, 4, 14, 242, 8, 14, 11, 14, 12, 14, 243, 1, 14, 1, 14, 1, 15, 
+	1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 5, 15, 256, 8, 15, 10, 
+	15, 12, 15, 259, 9, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 3, 17, 
+	267, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 3, 18, 276, 
+	8, 18, 1, 19, 1, 19, 1, 19, 3, 19, 281, 8, 19, 1, 20, 5, 20, 284, 8, 20, 
+	10, 20, 12, 20, 287, 9, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 22, 1, 
+	22, 5, 22, 296, 8, 22, 10, 22, 12, 22, 299, 9, 22, 1, 22, 5, 22, 302, 8, 
+	22, 10, 22, 12, 22, 305, 9, 22, 1, 22, 1, 22, 5, 22, 309, 8, 22, 10, 22, 
+	12, 22, 312, 9, 22, 1, 22, 3, 22, 315, 8, 22, 1, 22, 1, 22, 5, 22, 319, 
+	8, 22, 10, 22, 12, 22, 322, 9, 22, 1, 22, 5, 22, 325, 8, 22, 10, 22, 12, 
+	22, 328, 9, 22, 1, 22, 1, 22, 5, 22, 332, 8, 22, 10, 22, 12, 22, 335, 9, 
+	22, 1, 22, 3, 22, 338, 8, 22, 1, 22, 1, 22, 1, 22, 1, 22, 5, 22, 344, 8, 
+	22, 10, 22, 12, 22, 347, 9, 22, 1, 22, 3, 22, 350, 8, 22, 1, 22, 1, 22, 
+	1, 22, 1, 22, 5, 22, 356, 8, 22, 10, 22, 12, 22, 359, 9, 22, 1, 22, 3, 
+	22, 362, 8, 22, 1, 22, 1, 22, 3, 22, 366, 8, 22, 1, 22, 5, 22, 369, 8, 
+	22, 10, 22, 12, 22, 372, 9, 22, 1, 22, 1, 22, 3, 22, 376, 8, 22, 1, 22, 
+	1, 22, 1, 22, 1, 22, 5, 22, 382, 8, 22, 10, 22, 12, 22, 385, 9, 22, 1, 
+	22, 1, 22, 1, 22, 5, 22, 390, 8, 22, 10, 22, 12, 22, 393, 9, 22, 1, 22, 
+	3, 22, 396, 8, 22, 3, 22, 398, 8, 22, 1, 23, 1, 23, 1, 23, 5, 23, 403, 
+	8, 23, 10, 23, 12, 23, 406, 9, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 
+	24, 1, 24, 1, 24, 1, 24, 5, 24, 417, 8, 24, 10, 24, 12, 24, 420, 9, 24, 
+	1, 24, 0, 0, 25, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 
+	30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 0, 3, 2, 0, 35, 35, 37, 38, 1, 
+	0, 7, 11, 1, 0, 40, 41, 504, 0, 50, 1, 0, 0, 0, 2, 66, 1, 0, 0, 0, 4, 70, 
+	1, 0, 0, 0, 6, 86, 1, 0, 0, 0, 8, 98, 1, 0, 0, 0, 10, 114, 1, 0, 0, 0, 
+	12, 130, 1, 0, 0, 0, 14, 158, 1, 0, 0, 0, 16, 168, 1, 0, 0, 0, 18, 175, 
+	1, 0, 0, 0, 20, 183, 1, 0, 0, 0, 22, 187, 1, 0, 0, 0, 24, 202, 1, 0, 0, 
+	0, 26, 218, 1, 0, 0, 0, 28, 233, 1, 0, 0, 0, 30, 247, 1, 0, 0, 0, 32, 262, 
+	1, 0, 0, 0, 34, 266, 1, 0, 0, 0, 36, 275, 1, 0, 0, 0, 38, 280, 1, 0, 0, 
+	0, 40, 285, 1, 0, 0, 0, 42, 290, 1, 0, 0, 0, 44, 397, 1, 0, 0, 0, 46, 399, 
+	1, 0, 0, 0, 48, 418, 1, 0, 0, 0, 50, 51, 3, 2, 1, 0, 51, 55, 3, 18, 9, 
+	0, 52, 54, 5, 40, 0, 0, 53, 52, 1, 0, 0, 0, 54, 57, 1, 0, 0, 0, 55, 53, 
+	1, 0, 0, 0, 55, 56, 1, 0, 0, 0, 56, 58, 1, 0, 0, 0, 57, 55, 1, 0, 0, 0, 
+	58, 59, 3, 14, 7, 0, 59, 60, 5, 0, 0, 1, 60, 1, 1, 0, 0, 0, 61, 67, 3, 
+	4, 2, 0, 62, 67, 3, 6, 3, 0, 63, 67, 3, 8, 4, 0, 64, 67, 3, 10, 5, 0, 65, 
+	67, 3, 12, 6, 0, 66, 61, 1, 0, 0, 0, 66, 62, 1, 0, 0, 0, 66, 63, 1, 0, 
+	0, 0, 66, 64, 1, 0, 0, 0, 66, 65, 1, 0, 0, 0, 67, 68, 1, 0, 0, 0, 68, 66, 
+	1, 0, 0, 0, 68, 69, 1, 0, 0, 0, 69, 3, 1, 0, 0, 0, 70, 74, 5, 1, 0, 0, 
+	71, 73, 5, 41, 0, 0, 72, 71, 1, 0, 0, 0, 73, 76, 1, 0, 0, 0, 74, 72, 1, 
+	0, 0, 0, 74, 75, 1, 0, 0, 0, 75, 78, 1, 0, 0, 0, 76, 74, 1, 0, 0, 0, 77, 
+	79, 3, 36, 18, 0, 78, 77, 1, 0, 0, 0, 79, 80, 1, 0, 0, 0, 80, 78, 1, 0, 
+	0, 0, 80, 81, 1, 0, 0, 0, 81, 82, 1, 0, 0, 0, 82, 84, 5, 2, 0, 0, 83, 85, 
+	5, 40, 0, 0, 84, 83, 1, 0, 0, 0, 84, 85, 1, 0, 0, 0, 85, 5, 1, 0, 0, 0, 
+	86, 90, 5, 3, 0, 0, 87, 89, 5, 41, 0, 0, 88, 87, 1, 0, 0, 0, 89, 92, 1, 
+	0, 0, 0, 90, 88, 1, 0, 0, 0, 90, 91, 1, 0, 0, 0, 91, 93, 1, 0, 0, 0, 92, 
+	90, 1, 0, 0, 0, 93, 94, 5, 30, 0, 0, 94, 96, 5, 2, 0, 0, 95, 97, 5, 40, 
+	0, 0, 96, 95, 1, 0, 0, 0, 96, 97, 1, 0, 0, 0, 97, 7, 1, 0, 0, 0, 98, 102, 
+	5, 4, 0, 0, 99, 101, 5, 41, 0, 0, 100, 99, 1, 0, 0, 0, 101, 104, 1, 0, 
+	0, 0, 102, 100, 1, 0, 0, 0, 102, 103, 1, 0, 0, 0, 103, 106, 1, 0, 0, 0, 
+	104, 102, 1, 0, 0, 0, 105, 107, 3, 36, 18, 0, 106, 105, 1, 0, 0, 0, 107, 
+	108, 1, 0, 0, 0, 108, 106, 1, 0, 0, 0, 108, 109, 1, 0, 0, 0, 109, 110, 
+	1, 0, 0, 0, 110, 112, 5, 2, 0, 0, 111, 113, 5, 40, 0, 0, 112, 111, 1, 0, 
+	0, 0, 112, 113, 1, 0, 0, 0, 113, 9, 1, 0, 0, 0, 114, 118, 5, 5, 0, 0, 115, 
+	117, 5, 41, 0, 0, 116, 115, 1, 0, 0, 0, 117, 120, 1, 0, 0, 0, 118, 116, 
+	1, 0, 0, 0, 118, 119, 1, 0, 0, 0, 119, 122, 1, 0, 0, 0, 120, 118, 1, 0, 
+	0, 0, 121, 123, 3, 36, 18, 0, 122, 121, 1, 0, 0, 0, 123, 124, 1, 0, 0, 
+	0, 124, 122, 1, 0, 0, 0, 124, 125, 1, 0, 0, 0, 125, 126, 1, 0, 0, 0, 126, 
+	128, 5, 2, 0, 0, 127, 129, 5, 40, 0, 0, 128, 127, 1, 0, 0, 0, 128, 129, 
+	1, 0, 0, 0, 129, 11, 1, 0, 0, 0, 130, 134, 5, 27, 0, 0, 131, 133, 5, 41, 
+	0, 0, 132, 131, 1, 0, 0, 0, 133, 136, 1, 0, 0, 0, 134, 132, 1, 0, 0, 0, 
+	134, 135, 1, 0, 0, 0, 135, 145, 1, 0, 0, 0, 136, 134, 1, 0, 0, 0, 137, 
+	144, 3, 24, 12, 0, 138, 144, 3, 28, 14, 0, 139, 144, 3, 30, 15, 0, 140, 
+	144, 3, 32, 16, 0, 141, 144, 3, 34, 17, 0, 142, 144, 3, 36, 18, 0, 143, 
+	137, 1, 0, 0, 0, 143, 138, 1, 0, 0, 0, 143, 139, 1, 0, 0, 0, 143, 140, 
+	1, 0, 0, 0, 143, 141, 1, 0, 0, 0, 143, 142, 1, 0, 0, 0, 144, 147, 1, 0, 
+	0, 0, 145, 143, 1, 0, 0, 0, 145, 146, 1, 0, 0, 0, 146, 148, 1, 0, 0, 0, 
+	147, 145, 1, 0, 0, 0, 148, 150, 5, 2, 0, 0, 149, 151, 5, 40, 0, 0, 150, 
+	149, 1, 0, 0, 0, 150, 151, 1, 0, 0, 0, 151, 13, 1, 0, 0, 0, 152, 157, 3, 
+	16, 8, 0, 153, 157, 3, 44, 22, 0, 154, 157, 3, 18, 9, 0, 155, 157, 3, 20, 
+	10, 0, 156, 152, 1, 0, 0, 0, 156, 153, 1, 0, 0, 0, 156, 154, 1, 0, 0, 0, 
+	156, 155, 1, 0, 0, 0, 157, 160, 1, 0, 0, 0, 158, 156, 1, 0, 0, 0, 158, 
+	159, 1, 0, 0, 0, 159, 15, 1, 0, 0, 0, 160, 158, 1, 0, 0, 0, 161, 169, 3, 
+	24, 12, 0, 162, 169, 3, 26, 13, 0, 163, 169, 3, 28, 14, 0, 164, 169, 3, 
+	30, 15, 0, 165, 169, 3, 32, 16, 0, 166, 169, 3, 34, 17, 0, 167, 169, 3, 
+	36, 18, 0, 168, 161, 1, 0, 0, 0, 168, 162, 1, 0, 0, 0, 168, 163, 1, 0, 
+	0, 0, 168, 164, 1, 0, 0, 0, 168, 165, 1, 0, 0, 0, 168, 166, 1, 0, 0, 0, 
+	168, 167, 1, 0, 0, 0, 169, 170, 1, 0, 0, 0, 170, 168, 1, 0, 0, 0, 170, 
+	171, 1, 0, 0, 0, 171, 173, 1, 0, 0, 0, 172, 174, 5, 40, 0, 0, 173, 172, 
+	1, 0, 0, 0, 173, 174, 1, 0, 0, 0, 174, 17, 1, 0, 0, 0, 175, 179, 5, 2, 
+	0, 0, 176, 178, 5, 41, 0, 0, 177, 176, 1, 0, 0, 0, 178, 181, 1, 0, 0, 0, 
+	179, 177, 1, 0, 0, 0, 179, 180, 1, 0, 0, 0, 180, 19, 1, 0, 0, 0, 181, 179, 
+	1, 0, 0, 0, 182, 184, 5, 40, 0, 0, 183, 182, 1, 0, 0, 0, 184, 185, 1, 0, 
+	0, 0, 185, 183, 1, 0, 0, 0, 185, 186, 1, 0, 0, 0, 186, 21, 1, 0, 0, 0, 
+	187, 189, 5, 6, 0, 0, 188, 190, 7, 0, 0, 0, 189, 188, 1, 0, 0, 0, 190, 
+	191, 1, 0, 0, 0, 191, 189, 1, 0, 0, 0, 191, 192, 1, 0, 0, 0, 192, 196, 
+	1, 0, 0, 0, 193, 195, 5, 41, 0, 0, 194, 193, 1, 0, 0, 0, 195, 198, 1, 0, 
+	0, 0, 196, 194, 1, 0, 0, 0, 196, 197, 1, 0, 0, 0, 197, 200, 1, 0, 0, 0, 
+	198, 196, 1, 0, 0, 0, 199, 201, 5, 40, 0, 0, 200, 199, 1, 0, 0, 0, 200, 
+	201, 1, 0, 0, 0, 201, 23, 1, 0, 0, 0, 202, 213, 7, 1, 0, 0, 203, 212, 3, 
+	24, 12, 0, 204, 212, 3, 26, 13, 0, 205, 212, 3, 28, 14, 0, 206, 212, 3, 
+	30, 15, 0, 207, 212, 3, 32, 16, 0, 208, 212, 3, 34, 17, 0, 209, 212, 3, 
+	36, 18, 0, 210, 212, 5, 40, 0, 0, 211, 203, 1, 0, 0, 0, 211, 204, 1, 0, 
+	0, 0, 211, 205, 1, 0, 0, 0, 211, 206, 1, 0, 0, 0, 211, 207, 1, 0, 0, 0, 
+	211, 208, 1, 0, 0, 0, 211, 209, 1, 0, 0, 0, 211, 210, 1, 0, 0, 0, 212, 
+	215, 1, 0, 0, 0, 213, 211, 1, 0, 0, 0, 213, 214, 1, 0, 0, 0, 214, 216, 
+	1, 0, 0, 0, 215, 213, 1, 0, 0, 0, 216, 217, 5, 12, 0, 0, 217, 25, 1, 0, 
+	0, 0, 218, 228, 5, 13, 0, 0, 219, 227, 3, 24, 12, 0, 220, 227, 3, 28, 14, 
+	0, 221, 227, 3, 30, 15, 0, 222, 227, 3, 32, 16, 0, 223, 227, 3, 34, 17, 
+	0, 224, 227, 3, 36, 18, 0, 225, 227, 5, 40, 0, 0, 226, 219, 1, 0, 0, 0, 
+	226, 220, 1, 0, 0, 0, 226, 221, 1, 0, 0, 0, 226, 222, 1, 0, 0, 0, 226, 
+	223, 1, 0, 0, 0, 226, 224, 1, 0, 0, 0, 226, 225, 1, 0, 0, 0, 227, 230, 
+	1, 0, 0, 0, 228, 226, 1, 0, 0, 0, 228, 229, 1, 0, 0, 0, 229, 231, 1, 0, 
+	0, 0, 230, 228, 1, 0, 0, 0, 231, 232, 5, 12, 0, 0, 232, 27, 1, 0, 0, 0, 
+	233, 235, 5, 6, 0, 0, 234, 236, 5, 35, 0, 0, 235, 234, 1, 0, 0, 0, 236, 
+	237, 1, 0, 0, 0, 237, 235, 1, 0, 0, 0, 237, 238, 1, 0, 0, 0, 238, 239, 
+	1, 0, 0, 0, 239, 241, 5, 14, 0, 0, 240, 242, 3, 36, 18, 0, 241, 240, 1, 
+	0, 0, 0, 242, 243, 1, 0, 0, 0, 243, 241, 1, 0, 0, 0, 243, 244, 1, 0, 0, 
+	0, 244, 245, 1, 0, 0, 0, 245, 246, 5, 12, 0, 0, 246, 29, 1, 0, 0, 0, 247, 
+	248, 5, 31, 0, 0, 248, 257, 5, 14, 0, 0, 249, 256, 3, 24, 12, 0, 250, 256, 
+	3, 28, 14, 0, 251, 256, 3, 32, 16, 0, 252, 256, 3, 34, 17, 0, 253, 256, 
+	3, 36, 18, 0, 254, 256, 5, 40, 0, 0, 255, 249, 1, 0, 0, 0, 255, 250, 1, 
+	0, 0, 0, 255, 251, 1, 0, 0, 0, 255, 252, 1, 0, 0, 0, 255, 253, 1, 0, 0, 
+	0, 255, 254, 1, 0, 0, 0, 256, 259, 1, 0, 0, 0, 257, 255, 1, 0, 0, 0, 257, 
+	258, 1, 0, 0, 0, 258, 260, 1, 0, 0, 0, 259, 257, 1, 0, 0, 0, 260, 261, 
+	5, 12, 0, 0, 261, 31, 1, 0, 0, 0, 262, 263, 5, 30, 0, 0, 263, 33, 1, 0, 
+	0, 0, 264, 267, 5, 32, 0, 0, 265, 267, 5, 33, 0, 0, 266, 264, 1, 0, 0, 
+	0, 266, 265, 1, 0, 0, 0, 267, 35, 1, 0, 0, 0, 268, 276, 3, 22, 11, 0, 269, 
+	276, 5, 34, 0, 0, 270, 276, 5, 27, 0, 0, 271, 276, 5, 35, 0, 0, 272, 276, 
+	5, 36, 0, 0, 273, 276, 5, 39, 0, 0, 274, 276, 5, 41, 0, 0, 275, 268, 1, 
+	0, 0, 0, 275, 269, 1, 0, 0, 0, 275, 270, 1, 0, 0, 0, 275, 271, 1, 0, 0, 
+	0, 275, 272, 1, 0, 0, 0, 275, 273, 1, 0, 0, 0, 275, 274, 1, 0, 0, 0, 276, 
+	37, 1, 0, 0, 0, 277, 281, 3, 36, 18, 0, 278, 281, 5, 38, 0, 0, 279, 281, 
+	3, 18, 9, 0, 280, 277, 1, 0, 0, 0, 280, 278, 1, 0, 0, 0, 280, 279, 1, 0, 
+	0, 0, 281, 39, 1, 0, 0, 0, 282, 284, 3, 38, 19, 0, 283, 282, 1, 0, 0, 0, 
+	284, 287, 1, 0, 0, 0, 285, 283, 1, 0, 0, 0, 285, 286, 1, 0, 0, 0, 286, 
+	288, 1, 0, 0, 0, 287, 285, 1, 0, 0, 0, 288, 289, 5, 40, 0, 0, 289, 41, 
+	1, 0, 0, 0, 290, 291, 5, 15, 0, 0, 291, 292, 3, 14, 7, 0, 292, 43, 1, 0, 
+	0, 0, 293, 297, 5, 16, 0, 0, 294, 296, 7, 2, 0, 0, 295, 294, 1, 0, 0, 0, 
+	296, 299, 1, 0, 0, 0, 297, 295, 1, 0, 0, 0, 297, 298, 1, 0, 0, 0, 298, 
+	303, 1, 0, 0, 0, 299, 297, 1, 0, 0, 0, 300, 302, 3, 42, 21, 0, 301, 300, 
+	1, 0, 0, 0, 302, 305, 1, 0, 0, 0, 303, 301, 1, 0, 0, 0, 303, 304, 1, 0, 
+	0, 0, 304, 306, 1, 0, 0, 0, 305, 303, 1, 0, 0, 0, 306, 310, 5, 17, 0, 0, 
+	307, 309, 5, 41, 0, 0, 308, 307, 1, 0, 0, 0, 309, 312, 1, 0, 0, 0, 310, 
+	308, 1, 0, 0, 0, 310, 311, 1, 0, 0, 0, 311, 314, 1, 0, 0, 0, 312, 310, 
+	1, 0, 0, 0, 313, 315, 5, 40, 0, 0, 314, 313, 1, 0, 0, 0, 314, 315, 1, 0, 
+	0, 0, 315, 398, 1, 0, 0, 0, 316, 320, 5, 18, 0, 0, 317, 319, 7, 2, 0, 0, 
+	318, 317, 1, 0, 0, 0, 319, 322, 1, 0, 0, 0, 320, 318, 1, 0, 0, 0, 320, 
+	321, 1, 0, 0, 0, 321, 326, 1, 0, 0, 0, 322, 320, 1, 0, 0, 0, 323, 325, 
+	3, 42, 21, 0, 324, 323, 1, 0, 0, 0, 325, 328, 1, 0, 0, 0, 326, 324, 1, 
+	0, 0, 0, 326, 327, 1, 0, 0, 0, 327, 329, 1, 0, 0, 0, 328, 326, 1, 0, 0, 
+	0, 329, 333, 5, 19, 0, 0, 330, 332, 5, 41, 0, 0, 331, 330, 1, 0, 0, 0, 
+	332, 335, 1, 0, 0, 0, 333, 331, 1, 0, 0, 0, 333, 334, 1, 0, 0, 0, 334, 
+	337, 1, 0, 0, 0, 335, 333, 1, 0, 0, 0, 336, 338, 5, 40, 0, 0, 337, 336, 
+	1, 0, 0, 0, 337, 338, 1, 0, 0, 0, 338, 398, 1, 0, 0, 0, 339, 340, 5, 20, 
+	0, 0, 340, 341, 3, 14, 7, 0, 341, 345, 5, 21, 0, 0, 342, 344, 5, 41, 0, 
+	0, 343, 342, 1, 0, 0, 0, 344, 347, 1, 0, 0, 0, 345, 343, 1, 0, 0, 0, 345, 
+	346, 1, 0, 0, 0, 346, 349, 1, 0, 0, 0, 347, 345, 1, 0, 0, 0, 348, 350, 
+	5, 40, 0, 0, 349, 348, 1, 0, 0, 0, 349, 350, 1, 0, 0, 0, 350, 398, 1, 0, 
+	0, 0, 351, 352, 5, 22, 0, 0, 352, 353, 3, 14, 7, 0, 353, 357, 5, 23, 0, 
+	0, 354, 356, 5, 41, 0, 0, 355, 354, 1, 0, 0, 0, 356, 359, 1, 0, 0, 0, 357, 
+	355, 1, 0, 0, 0, 357, 358, 1, 0, 0, 0, 358, 361, 1, 0, 0, 0, 359, 357, 
+	1, 0, 0, 0, 360, 362, 5, 40, 0, 0, 361, 360, 1, 0, 0, 0, 361, 362, 1, 0, 
+	0, 0, 362, 398, 1, 0, 0, 0, 363, 365, 5, 24, 0, 0, 364, 366, 5, 40, 0, 
+	0, 365, 364, 1, 0, 0, 0, 365, 366, 1, 0, 0, 0, 366, 370, 1, 0, 0, 0, 367, 
+	369, 3, 40, 20, 0, 368, 367, 1, 0, 0, 0, 369, 372, 1, 0, 0, 0, 370, 368, 
+	1, 0, 0, 0, 370, 371, 1, 0, 0, 0, 371, 373, 1, 0, 0, 0, 372, 370, 1, 0, 
+	0, 0, 373, 375, 5, 25, 0, 0, 374, 376, 5, 40, 0, 0, 375, 374, 1, 0, 0, 
+	0, 375, 376, 1, 0, 0, 0, 376, 398, 1, 0, 0, 0, 377, 383, 5, 28, 0, 0, 378, 
+	379, 3, 46, 23, 0, 379, 380, 5, 2, 0, 0, 380, 382, 1, 0, 0, 0, 381, 378, 
+	1, 0, 0, 0, 382, 385, 1, 0, 0, 0, 383, 381, 1, 0, 0, 0, 383, 384, 1, 0, 
+	0, 0, 384, 386, 1, 0, 0, 0, 385, 383, 1, 0, 0, 0, 386, 387, 3, 46, 23, 
+	0, 387, 391, 5, 26, 0, 0, 388, 390, 5, 41, 0, 0, 389, 388, 1, 0, 0, 0, 
+	390, 393, 1, 0, 0, 0, 391, 389, 1, 0, 0, 0, 391, 392, 1, 0, 0, 0, 392, 
+	395, 1, 0, 0, 0, 393, 391, 1, 0, 0, 0, 394, 396, 5, 40, 0, 0, 395, 394, 
+	1, 0, 0, 0, 395, 396, 1, 0, 0, 0, 396, 398, 1, 0, 0, 0, 397, 293, 1, 0, 
+	0, 0, 397, 316, 1, 0, 0, 0, 397, 339, 1, 0, 0, 0, 397, 351, 1, 0, 0, 0, 
+	397, 363, 1, 0, 0, 0, 397, 377, 1, 0, 0, 0, 398, 45, 1, 0, 0, 0, 399, 404, 
+	3, 48, 24, 0, 400, 401, 5, 37, 0, 0, 401, 403, 3, 48, 24, 0, 402, 400, 
+	1, 0, 0, 0, 403, 406, 1, 0, 0, 0, 404, 402, 1, 0, 0, 0, 404, 405, 1, 0, 
+	0, 0, 405, 47, 1, 0, 0, 0, 406, 404, 1, 0, 0, 0, 407, 417, 3, 24, 12, 0, 
+	408, 417, 3, 26, 13, 0, 409, 417, 3, 28, 14, 0, 410, 417, 3, 30, 15, 0, 
+	411, 417, 3, 32, 16, 0, 412, 417, 3, 34, 17, 0, 413, 417, 3, 36, 18, 0, 
+	414, 417, 5, 29, 0, 0, 415, 417, 5, 40, 0, 0, 416, 407, 1, 0, 0, 0, 416, 
+	408, 1, 0, 0, 0, 416, 409, 1, 0, 0, 0, 416, 410, 1, 0, 0, 0, 416, 411, 
+	1, 0, 0, 0, 416, 412, 1, 0, 0, 0, 416, 413, 1, 0, 0, 0, 416, 414, 1, 0, 
+	0, 0, 416, 415, 1, 0, 0, 0, 417, 420, 1, 0, 0, 0, 418, 416, 1, 0, 0, 0, 
+	418, 419, 1, 0, 0, 0, 419, 49, 1, 0, 0, 0, 420, 418, 1, 0, 0, 0, 62, 55, 
+	66, 68, 74, 80, 84, 90, 96, 102, 108, 112, 118, 124, 128, 134, 143, 145, 
+	150, 156, 158, 168, 170, 173, 179, 185, 191, 196, 200, 211, 213, 226, 228, 
+	237, 243, 255, 257, 266, 275, 280, 285, 297, 303, 310, 314, 320, 326, 333, 
+	337, 345, 349, 357, 361, 365, 370, 375, 383, 391, 395, 397, 404, 416, 418,
 }
   deserializer := antlr.NewATNDeserializer(nil)
   staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -221,34 +316,62 @@ const (
 	LatexParserT__12 = 13
 	LatexParserT__13 = 14
 	LatexParserT__14 = 15
-	LatexParserLETTER = 16
-	LatexParserPUNCTUATION = 17
-	LatexParserSYMBOL = 18
-	LatexParserNUMBER = 19
-	LatexParserNEWLINE = 20
-	LatexParserWS = 21
-	LatexParserCR = 22
+	LatexParserT__15 = 16
+	LatexParserT__16 = 17
+	LatexParserT__17 = 18
+	LatexParserT__18 = 19
+	LatexParserT__19 = 20
+	LatexParserT__20 = 21
+	LatexParserT__21 = 22
+	LatexParserT__22 = 23
+	LatexParserT__23 = 24
+	LatexParserT__24 = 25
+	LatexParserT__25 = 26
+	LatexParserMETADATA_KEY = 27
+	LatexParserTABULAR = 28
+	LatexParserTABLE_RULE = 29
+	LatexParserURL = 30
+	LatexParserHREF = 31
+	LatexParserINLINE_MATH = 32
+	LatexParserDISPLAY_MATH = 33
+	LatexParserDOLLAR = 34
+	LatexParserLETTER = 35
+	LatexParserPUNCTUATION = 36
+	LatexParserAMPERSAND = 37
+	LatexParserSYMBOL = 38
+	LatexParserNUMBER = 39
+	LatexParserNEWLINE = 40
+	LatexParserWS = 41
+	LatexParserCR = 42
 )
 
 // LatexParser rules.
 const (
 	LatexParserRULE_latex = 0
-	LatexParserRULE_note_title = 1
-	LatexParserRULE_note_url = 2
-	LatexParserRULE_note_created = 3
-	LatexParserRULE_note_updated = 4
-	LatexParserRULE_note_text = 5
-	LatexParserRULE_text = 6
-	LatexParserRULE_line_break = 7
-	LatexParserRULE_empty_line = 8
-	LatexParserRULE_escaped_word = 9
-	LatexParserRULE_tag = 10
-	LatexParserRULE_word = 11
-	LatexParserRULE_verbatim_content = 12
-	LatexParserRULE_verbatim_line = 13
-	LatexParserRULE_block_line = 14
-	LatexParserRULE_block_item = 15
-	LatexParserRULE_block = 16
+	LatexParserRULE_header = 1
+	LatexParserRULE_note_title = 2
+	LatexParserRULE_note_url = 3
+	LatexParserRULE_note_created = 4
+	LatexParserRULE_note_updated = 5
+	LatexParserRULE_note_metadata = 6
+	LatexParserRULE_note_text = 7
+	LatexParserRULE_text = 8
+	LatexParserRULE_line_break = 9
+	LatexParserRULE_empty_line = 10
+	LatexParserRULE_escaped_word = 11
+	LatexParserRULE_tag = 12
+	LatexParserRULE_footnote = 13
+	LatexParserRULE_command = 14
+	LatexParserRULE_href = 15
+	LatexParserRULE_url = 16
+	LatexParserRULE_math = 17
+	LatexParserRULE_word = 18
+	LatexParserRULE_verbatim_content = 19
+	LatexParserRULE_verbatim_line = 20
+	LatexParserRULE_block_item = 21
+	LatexParserRULE_block = 22
+	LatexParserRULE_table_row = 23
+	LatexParserRULE_table_cell = 24
 )
 
 // ILatexContext is an interface to support dynamic dispatch.
@@ -259,10 +382,7 @@ type ILatexContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Note_title() INote_titleContext
-	Note_url() INote_urlContext
-	Note_created() INote_createdContext
-	Note_updated() INote_updatedContext
+	Header() IHeaderContext
 	Line_break() ILine_breakContext
 	Note_text() INote_textContext
 	EOF() antlr.TerminalNode
@@ -305,58 +425,10 @@ func NewLatexContext(parser antlr.Parser, parent antlr.ParserRuleContext, invoki
 
 func (s *LatexContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LatexContext) Note_title() INote_titleContext {
+func (s *LatexContext) Header() IHeaderContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INote_titleContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INote_titleContext)
-}
-
-func (s *LatexContext) Note_url() INote_urlContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INote_urlContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INote_urlContext)
-}
-
-func (s *LatexContext) Note_created() INote_createdContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INote_createdContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INote_createdContext)
-}
-
-func (s *LatexContext) Note_updated() INote_updatedContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INote_updatedContext); ok {
+		if _, ok := ctx.(IHeaderContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -366,7 +438,7 @@ func (s *LatexContext) Note_updated() INote_updatedContext {
 		return nil
 	}
 
-	return t.(INote_updatedContext)
+	return t.(IHeaderContext)
 }
 
 func (s *LatexContext) Line_break() ILine_breakContext {
@@ -444,26 +516,14 @@ func (p *LatexParser) Latex() (localctx ILatexContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(34)
-		p.Note_title()
-	}
-	{
-		p.SetState(35)
-		p.Note_url()
-	}
-	{
-		p.SetState(36)
-		p.Note_created()
-	}
-	{
-		p.SetState(37)
-		p.Note_updated()
+		p.SetState(50)
+		p.Header()
 	}
 	{
-		p.SetState(38)
+		p.SetState(51)
 		p.Line_break()
 	}
-	p.SetState(42)
+	p.SetState(55)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -475,7 +535,7 @@ func (p *LatexParser) Latex() (localctx ILatexContext) {
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(39)
+				p.SetState(52)
 				p.Match(LatexParserNEWLINE)
 				if p.HasError() {
 						// Recognition error - abort rule
@@ -485,7 +545,7 @@ func (p *LatexParser) Latex() (localctx ILatexContext) {
 
 
 		}
-		p.SetState(44)
+		p.SetState(57)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 	    	goto errorExit
@@ -496,11 +556,11 @@ func (p *LatexParser) Latex() (localctx ILatexContext) {
 		}
 	}
 	{
-		p.SetState(45)
+		p.SetState(58)
 		p.Note_text()
 	}
 	{
-		p.SetState(46)
+		p.SetState(59)
 		p.Match(LatexParserEOF)
 		if p.HasError() {
 				// Recognition error - abort rule
@@ -524,78 +584,75 @@ errorExit:
 }
 
 
-// INote_titleContext is an interface to support dynamic dispatch.
-type INote_titleContext interface {
+// IHeaderContext is an interface to support dynamic dispatch.
+type IHeaderContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllWS() []antlr.TerminalNode
-	WS(i int) antlr.TerminalNode
-	AllWord() []IWordContext
-	Word(i int) IWordContext
-	NEWLINE() antlr.TerminalNode
-
-	// IsNote_titleContext differentiates from other interfaces.
-	IsNote_titleContext()
-}
-
-type Note_titleContext struct {
+	AllNote_title() []INote_titleContext
+	Note_title(i int) INote_titleContext
+	AllNote_url() []INote_urlContext
+	Note_url(i int) INote_urlContext
+	AllNote_created() []INote_createdContext
+	Note_created(i int) INote_createdContext
+	AllNote_updated() []INote_updatedContext
+	Note_updated(i int) INote_updatedContext
+	AllNote_metadata() []INote_metadataContext
+	Note_metadata(i int) INote_metadataContext
+
+	// IsHeaderContext differentiates from other interfaces.
+	IsHeaderContext()
+}
+
+type HeaderContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNote_titleContext() *Note_titleContext {
-	var p = new(Note_titleContext)
+func NewEmptyHeaderContext() *HeaderContext {
+	var p = new(HeaderContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_title
+	p.RuleIndex = LatexParserRULE_header
 	return p
 }
 
-func InitEmptyNote_titleContext(p *Note_titleContext)  {
+func InitEmptyHeaderContext(p *HeaderContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_title
+	p.RuleIndex = LatexParserRULE_header
 }
 
-func (*Note_titleContext) IsNote_titleContext() {}
+func (*HeaderContext) IsHeaderContext() {}
 
-func NewNote_titleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_titleContext {
-	var p = new(Note_titleContext)
+func NewHeaderContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HeaderContext {
+	var p = new(HeaderContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_note_title
+	p.RuleIndex = LatexParserRULE_header
 
 	return p
 }
 
-func (s *Note_titleContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Note_titleContext) AllWS() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserWS)
-}
-
-func (s *Note_titleContext) WS(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserWS, i)
-}
+func (s *HeaderContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Note_titleContext) AllWord() []IWordContext {
+func (s *HeaderContext) AllNote_title() []INote_titleContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IWordContext); ok {
+		if _, ok := ctx.(INote_titleContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IWordContext, len)
+	tst := make([]INote_titleContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IWordContext); ok {
-			tst[i] = t.(IWordContext)
+		if t, ok := ctx.(INote_titleContext); ok {
+			tst[i] = t.(INote_titleContext)
 			i++
 		}
 	}
@@ -603,11 +660,11 @@ func (s *Note_titleContext) AllWord() []IWordContext {
 	return tst
 }
 
-func (s *Note_titleContext) Word(i int) IWordContext {
+func (s *HeaderContext) Note_title(i int) INote_titleContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWordContext); ok {
+		if _, ok := ctx.(INote_titleContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -620,213 +677,146 @@ func (s *Note_titleContext) Word(i int) IWordContext {
 		return nil
 	}
 
-	return t.(IWordContext)
+	return t.(INote_titleContext)
 }
 
-func (s *Note_titleContext) NEWLINE() antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, 0)
-}
+func (s *HeaderContext) AllNote_url() []INote_urlContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INote_urlContext); ok {
+			len++
+		}
+	}
 
-func (s *Note_titleContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	tst := make([]INote_urlContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INote_urlContext); ok {
+			tst[i] = t.(INote_urlContext)
+			i++
+		}
+	}
 
-func (s *Note_titleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	return tst
 }
 
-
-func (s *Note_titleContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterNote_title(s)
+func (s *HeaderContext) Note_url(i int) INote_urlContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INote_urlContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
 	}
-}
 
-func (s *Note_titleContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitNote_title(s)
+	if t == nil {
+		return nil
 	}
-}
-
-
-
-
-func (p *LatexParser) Note_title() (localctx INote_titleContext) {
-	localctx = NewNote_titleContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, LatexParserRULE_note_title)
-	var _la int
 
-	var _alt int
+	return t.(INote_urlContext)
+}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(48)
-		p.Match(LatexParserT__0)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+func (s *HeaderContext) AllNote_created() []INote_createdContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INote_createdContext); ok {
+			len++
 		}
 	}
-	p.SetState(52)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 1, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
+
+	tst := make([]INote_createdContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INote_createdContext); ok {
+			tst[i] = t.(INote_createdContext)
+			i++
+		}
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(49)
-				p.Match(LatexParserWS)
-				if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-				}
-			}
 
+	return tst
+}
 
-		}
-		p.SetState(54)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-	    	goto errorExit
-	    }
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 1, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+func (s *HeaderContext) Note_created(i int) INote_createdContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INote_createdContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
 		}
 	}
-	p.SetState(56)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+
+	if t == nil {
+		return nil
 	}
-	_la = p.GetTokenStream().LA(1)
 
+	return t.(INote_createdContext)
+}
 
-	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2818176) != 0) {
-		{
-			p.SetState(55)
-			p.Word()
+func (s *HeaderContext) AllNote_updated() []INote_updatedContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INote_updatedContext); ok {
+			len++
 		}
-
-
-		p.SetState(58)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-	    	goto errorExit
-	    }
-		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(60)
-		p.Match(LatexParserT__1)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+
+	tst := make([]INote_updatedContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INote_updatedContext); ok {
+			tst[i] = t.(INote_updatedContext)
+			i++
 		}
 	}
-	p.SetState(62)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
+	return tst
+}
 
-	if _la == LatexParserNEWLINE {
-		{
-			p.SetState(61)
-			p.Match(LatexParserNEWLINE)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
+func (s *HeaderContext) Note_updated(i int) INote_updatedContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INote_updatedContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
 			}
+			j++
 		}
+	}
 
+	if t == nil {
+		return nil
 	}
 
+	return t.(INote_updatedContext)
+}
 
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-
-// INote_urlContext is an interface to support dynamic dispatch.
-type INote_urlContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	AllWord() []IWordContext
-	Word(i int) IWordContext
-	NEWLINE() antlr.TerminalNode
-
-	// IsNote_urlContext differentiates from other interfaces.
-	IsNote_urlContext()
-}
-
-type Note_urlContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyNote_urlContext() *Note_urlContext {
-	var p = new(Note_urlContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_url
-	return p
-}
-
-func InitEmptyNote_urlContext(p *Note_urlContext)  {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_url
-}
-
-func (*Note_urlContext) IsNote_urlContext() {}
-
-func NewNote_urlContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_urlContext {
-	var p = new(Note_urlContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = LatexParserRULE_note_url
-
-	return p
-}
-
-func (s *Note_urlContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Note_urlContext) AllWord() []IWordContext {
+func (s *HeaderContext) AllNote_metadata() []INote_metadataContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IWordContext); ok {
+		if _, ok := ctx.(INote_metadataContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IWordContext, len)
+	tst := make([]INote_metadataContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IWordContext); ok {
-			tst[i] = t.(IWordContext)
+		if t, ok := ctx.(INote_metadataContext); ok {
+			tst[i] = t.(INote_metadataContext)
 			i++
 		}
 	}
@@ -834,11 +824,11 @@ func (s *Note_urlContext) AllWord() []IWordContext {
 	return tst
 }
 
-func (s *Note_urlContext) Word(i int) IWordContext {
+func (s *HeaderContext) Note_metadata(i int) INote_metadataContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWordContext); ok {
+		if _, ok := ctx.(INote_metadataContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -851,51 +841,39 @@ func (s *Note_urlContext) Word(i int) IWordContext {
 		return nil
 	}
 
-	return t.(IWordContext)
-}
-
-func (s *Note_urlContext) NEWLINE() antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, 0)
+	return t.(INote_metadataContext)
 }
 
-func (s *Note_urlContext) GetRuleContext() antlr.RuleContext {
+func (s *HeaderContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Note_urlContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *HeaderContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *Note_urlContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *HeaderContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterNote_url(s)
+		listenerT.EnterHeader(s)
 	}
 }
 
-func (s *Note_urlContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *HeaderContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitNote_url(s)
+		listenerT.ExitHeader(s)
 	}
 }
 
 
 
 
-func (p *LatexParser) Note_url() (localctx INote_urlContext) {
-	localctx = NewNote_urlContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, LatexParserRULE_note_url)
+func (p *LatexParser) Header() (localctx IHeaderContext) {
+	localctx = NewHeaderContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, LatexParserRULE_header)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(64)
-		p.Match(LatexParserT__2)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-		}
-	}
 	p.SetState(66)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
@@ -904,12 +882,54 @@ func (p *LatexParser) Note_url() (localctx INote_urlContext) {
 	_la = p.GetTokenStream().LA(1)
 
 
-	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2818176) != 0) {
-		{
-			p.SetState(65)
-			p.Word()
+	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 134217786) != 0) {
+		p.SetState(66)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
 
+		switch p.GetTokenStream().LA(1) {
+		case LatexParserT__0:
+			{
+				p.SetState(61)
+				p.Note_title()
+			}
+
+
+		case LatexParserT__2:
+			{
+				p.SetState(62)
+				p.Note_url()
+			}
+
+
+		case LatexParserT__3:
+			{
+				p.SetState(63)
+				p.Note_created()
+			}
+
+
+		case LatexParserT__4:
+			{
+				p.SetState(64)
+				p.Note_updated()
+			}
+
+
+		case LatexParserMETADATA_KEY:
+			{
+				p.SetState(65)
+				p.Note_metadata()
+			}
+
+
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
 
 		p.SetState(68)
 		p.GetErrorHandler().Sync(p)
@@ -918,41 +938,6 @@ func (p *LatexParser) Note_url() (localctx INote_urlContext) {
 	    }
 		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(70)
-		p.Match(LatexParserT__3)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-		}
-	}
-	{
-		p.SetState(71)
-		p.Match(LatexParserT__1)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-		}
-	}
-	p.SetState(73)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == LatexParserNEWLINE {
-		{
-			p.SetState(72)
-			p.Match(LatexParserNEWLINE)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-			}
-		}
-
-	}
 
 
 
@@ -970,8 +955,8 @@ errorExit:
 }
 
 
-// INote_createdContext is an interface to support dynamic dispatch.
-type INote_createdContext interface {
+// INote_titleContext is an interface to support dynamic dispatch.
+type INote_titleContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -984,51 +969,51 @@ type INote_createdContext interface {
 	Word(i int) IWordContext
 	NEWLINE() antlr.TerminalNode
 
-	// IsNote_createdContext differentiates from other interfaces.
-	IsNote_createdContext()
+	// IsNote_titleContext differentiates from other interfaces.
+	IsNote_titleContext()
 }
 
-type Note_createdContext struct {
+type Note_titleContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNote_createdContext() *Note_createdContext {
-	var p = new(Note_createdContext)
+func NewEmptyNote_titleContext() *Note_titleContext {
+	var p = new(Note_titleContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_created
+	p.RuleIndex = LatexParserRULE_note_title
 	return p
 }
 
-func InitEmptyNote_createdContext(p *Note_createdContext)  {
+func InitEmptyNote_titleContext(p *Note_titleContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_created
+	p.RuleIndex = LatexParserRULE_note_title
 }
 
-func (*Note_createdContext) IsNote_createdContext() {}
+func (*Note_titleContext) IsNote_titleContext() {}
 
-func NewNote_createdContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_createdContext {
-	var p = new(Note_createdContext)
+func NewNote_titleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_titleContext {
+	var p = new(Note_titleContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_note_created
+	p.RuleIndex = LatexParserRULE_note_title
 
 	return p
 }
 
-func (s *Note_createdContext) GetParser() antlr.Parser { return s.parser }
+func (s *Note_titleContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Note_createdContext) AllWS() []antlr.TerminalNode {
+func (s *Note_titleContext) AllWS() []antlr.TerminalNode {
 	return s.GetTokens(LatexParserWS)
 }
 
-func (s *Note_createdContext) WS(i int) antlr.TerminalNode {
+func (s *Note_titleContext) WS(i int) antlr.TerminalNode {
 	return s.GetToken(LatexParserWS, i)
 }
 
-func (s *Note_createdContext) AllWord() []IWordContext {
+func (s *Note_titleContext) AllWord() []IWordContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -1049,7 +1034,7 @@ func (s *Note_createdContext) AllWord() []IWordContext {
 	return tst
 }
 
-func (s *Note_createdContext) Word(i int) IWordContext {
+func (s *Note_titleContext) Word(i int) IWordContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -1069,63 +1054,63 @@ func (s *Note_createdContext) Word(i int) IWordContext {
 	return t.(IWordContext)
 }
 
-func (s *Note_createdContext) NEWLINE() antlr.TerminalNode {
+func (s *Note_titleContext) NEWLINE() antlr.TerminalNode {
 	return s.GetToken(LatexParserNEWLINE, 0)
 }
 
-func (s *Note_createdContext) GetRuleContext() antlr.RuleContext {
+func (s *Note_titleContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Note_createdContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Note_titleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *Note_createdContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Note_titleContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterNote_created(s)
+		listenerT.EnterNote_title(s)
 	}
 }
 
-func (s *Note_createdContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Note_titleContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitNote_created(s)
+		listenerT.ExitNote_title(s)
 	}
 }
 
 
 
 
-func (p *LatexParser) Note_created() (localctx INote_createdContext) {
-	localctx = NewNote_createdContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, LatexParserRULE_note_created)
+func (p *LatexParser) Note_title() (localctx INote_titleContext) {
+	localctx = NewNote_titleContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, LatexParserRULE_note_title)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(75)
-		p.Match(LatexParserT__4)
+		p.SetState(70)
+		p.Match(LatexParserT__0)
 		if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 		}
 	}
-	p.SetState(79)
+	p.SetState(74)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(76)
+				p.SetState(71)
 				p.Match(LatexParserWS)
 				if p.HasError() {
 						// Recognition error - abort rule
@@ -1135,17 +1120,17 @@ func (p *LatexParser) Note_created() (localctx INote_createdContext) {
 
 
 		}
-		p.SetState(81)
+		p.SetState(76)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 	    	goto errorExit
 	    }
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(83)
+	p.SetState(78)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1153,14 +1138,14 @@ func (p *LatexParser) Note_created() (localctx INote_createdContext) {
 	_la = p.GetTokenStream().LA(1)
 
 
-	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2818176) != 0) {
+	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2869172371520) != 0) {
 		{
-			p.SetState(82)
+			p.SetState(77)
 			p.Word()
 		}
 
 
-		p.SetState(85)
+		p.SetState(80)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 	    	goto errorExit
@@ -1168,14 +1153,14 @@ func (p *LatexParser) Note_created() (localctx INote_createdContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(87)
+		p.SetState(82)
 		p.Match(LatexParserT__1)
 		if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 		}
 	}
-	p.SetState(89)
+	p.SetState(84)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1185,7 +1170,7 @@ func (p *LatexParser) Note_created() (localctx INote_createdContext) {
 
 	if _la == LatexParserNEWLINE {
 		{
-			p.SetState(88)
+			p.SetState(83)
 			p.Match(LatexParserNEWLINE)
 			if p.HasError() {
 					// Recognition error - abort rule
@@ -1211,74 +1196,255 @@ errorExit:
 }
 
 
-// INote_updatedContext is an interface to support dynamic dispatch.
-type INote_updatedContext interface {
+// INote_urlContext is an interface to support dynamic dispatch.
+type INote_urlContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
+	URL() antlr.TerminalNode
 	AllWS() []antlr.TerminalNode
 	WS(i int) antlr.TerminalNode
-	AllWord() []IWordContext
-	Word(i int) IWordContext
 	NEWLINE() antlr.TerminalNode
 
-	// IsNote_updatedContext differentiates from other interfaces.
-	IsNote_updatedContext()
+	// IsNote_urlContext differentiates from other interfaces.
+	IsNote_urlContext()
 }
 
-type Note_updatedContext struct {
+type Note_urlContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNote_updatedContext() *Note_updatedContext {
-	var p = new(Note_updatedContext)
+func NewEmptyNote_urlContext() *Note_urlContext {
+	var p = new(Note_urlContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_updated
+	p.RuleIndex = LatexParserRULE_note_url
 	return p
 }
 
-func InitEmptyNote_updatedContext(p *Note_updatedContext)  {
+func InitEmptyNote_urlContext(p *Note_urlContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_updated
+	p.RuleIndex = LatexParserRULE_note_url
 }
 
-func (*Note_updatedContext) IsNote_updatedContext() {}
+func (*Note_urlContext) IsNote_urlContext() {}
 
-func NewNote_updatedContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_updatedContext {
-	var p = new(Note_updatedContext)
+func NewNote_urlContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_urlContext {
+	var p = new(Note_urlContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_note_updated
+	p.RuleIndex = LatexParserRULE_note_url
 
 	return p
 }
 
-func (s *Note_updatedContext) GetParser() antlr.Parser { return s.parser }
+func (s *Note_urlContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Note_updatedContext) AllWS() []antlr.TerminalNode {
+func (s *Note_urlContext) URL() antlr.TerminalNode {
+	return s.GetToken(LatexParserURL, 0)
+}
+
+func (s *Note_urlContext) AllWS() []antlr.TerminalNode {
 	return s.GetTokens(LatexParserWS)
 }
 
-func (s *Note_updatedContext) WS(i int) antlr.TerminalNode {
+func (s *Note_urlContext) WS(i int) antlr.TerminalNode {
 	return s.GetToken(LatexParserWS, i)
 }
 
-func (s *Note_updatedContext) AllWord() []IWordContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IWordContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IWordContext, len)
+func (s *Note_urlContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, 0)
+}
+
+func (s *Note_urlContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Note_urlContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *Note_urlContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterNote_url(s)
+	}
+}
+
+func (s *Note_urlContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitNote_url(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Note_url() (localctx INote_urlContext) {
+	localctx = NewNote_urlContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, LatexParserRULE_note_url)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(86)
+		p.Match(LatexParserT__2)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(90)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	for _la == LatexParserWS {
+		{
+			p.SetState(87)
+			p.Match(LatexParserWS)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+		p.SetState(92)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(93)
+		p.Match(LatexParserURL)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	{
+		p.SetState(94)
+		p.Match(LatexParserT__1)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(96)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == LatexParserNEWLINE {
+		{
+			p.SetState(95)
+			p.Match(LatexParserNEWLINE)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// INote_createdContext is an interface to support dynamic dispatch.
+type INote_createdContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllWS() []antlr.TerminalNode
+	WS(i int) antlr.TerminalNode
+	AllWord() []IWordContext
+	Word(i int) IWordContext
+	NEWLINE() antlr.TerminalNode
+
+	// IsNote_createdContext differentiates from other interfaces.
+	IsNote_createdContext()
+}
+
+type Note_createdContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNote_createdContext() *Note_createdContext {
+	var p = new(Note_createdContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_note_created
+	return p
+}
+
+func InitEmptyNote_createdContext(p *Note_createdContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_note_created
+}
+
+func (*Note_createdContext) IsNote_createdContext() {}
+
+func NewNote_createdContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_createdContext {
+	var p = new(Note_createdContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_note_created
+
+	return p
+}
+
+func (s *Note_createdContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Note_createdContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
+}
+
+func (s *Note_createdContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *Note_createdContext) AllWord() []IWordContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IWordContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IWordContext, len)
 	i := 0
 	for _, ctx := range children {
 		if t, ok := ctx.(IWordContext); ok {
@@ -1290,7 +1456,7 @@ func (s *Note_updatedContext) AllWord() []IWordContext {
 	return tst
 }
 
-func (s *Note_updatedContext) Word(i int) IWordContext {
+func (s *Note_createdContext) Word(i int) IWordContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -1310,63 +1476,63 @@ func (s *Note_updatedContext) Word(i int) IWordContext {
 	return t.(IWordContext)
 }
 
-func (s *Note_updatedContext) NEWLINE() antlr.TerminalNode {
+func (s *Note_createdContext) NEWLINE() antlr.TerminalNode {
 	return s.GetToken(LatexParserNEWLINE, 0)
 }
 
-func (s *Note_updatedContext) GetRuleContext() antlr.RuleContext {
+func (s *Note_createdContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Note_updatedContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Note_createdContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *Note_updatedContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Note_createdContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterNote_updated(s)
+		listenerT.EnterNote_created(s)
 	}
 }
 
-func (s *Note_updatedContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Note_createdContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitNote_updated(s)
+		listenerT.ExitNote_created(s)
 	}
 }
 
 
 
 
-func (p *LatexParser) Note_updated() (localctx INote_updatedContext) {
-	localctx = NewNote_updatedContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, LatexParserRULE_note_updated)
+func (p *LatexParser) Note_created() (localctx INote_createdContext) {
+	localctx = NewNote_createdContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, LatexParserRULE_note_created)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(91)
-		p.Match(LatexParserT__5)
+		p.SetState(98)
+		p.Match(LatexParserT__3)
 		if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 		}
 	}
-	p.SetState(95)
+	p.SetState(102)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 8, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(92)
+				p.SetState(99)
 				p.Match(LatexParserWS)
 				if p.HasError() {
 						// Recognition error - abort rule
@@ -1376,17 +1542,17 @@ func (p *LatexParser) Note_updated() (localctx INote_updatedContext) {
 
 
 		}
-		p.SetState(97)
+		p.SetState(104)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 	    	goto errorExit
 	    }
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 8, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(99)
+	p.SetState(106)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1394,14 +1560,14 @@ func (p *LatexParser) Note_updated() (localctx INote_updatedContext) {
 	_la = p.GetTokenStream().LA(1)
 
 
-	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2818176) != 0) {
+	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2869172371520) != 0) {
 		{
-			p.SetState(98)
+			p.SetState(105)
 			p.Word()
 		}
 
 
-		p.SetState(101)
+		p.SetState(108)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 	    	goto errorExit
@@ -1409,14 +1575,14 @@ func (p *LatexParser) Note_updated() (localctx INote_updatedContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(103)
+		p.SetState(110)
 		p.Match(LatexParserT__1)
 		if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 		}
 	}
-	p.SetState(105)
+	p.SetState(112)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1426,7 +1592,7 @@ func (p *LatexParser) Note_updated() (localctx INote_updatedContext) {
 
 	if _la == LatexParserNEWLINE {
 		{
-			p.SetState(104)
+			p.SetState(111)
 			p.Match(LatexParserNEWLINE)
 			if p.HasError() {
 					// Recognition error - abort rule
@@ -1452,73 +1618,78 @@ errorExit:
 }
 
 
-// INote_textContext is an interface to support dynamic dispatch.
-type INote_textContext interface {
+// INote_updatedContext is an interface to support dynamic dispatch.
+type INote_updatedContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllText() []ITextContext
-	Text(i int) ITextContext
-	AllBlock() []IBlockContext
-	Block(i int) IBlockContext
-	AllLine_break() []ILine_breakContext
-	Line_break(i int) ILine_breakContext
-	AllEmpty_line() []IEmpty_lineContext
-	Empty_line(i int) IEmpty_lineContext
+	AllWS() []antlr.TerminalNode
+	WS(i int) antlr.TerminalNode
+	AllWord() []IWordContext
+	Word(i int) IWordContext
+	NEWLINE() antlr.TerminalNode
 
-	// IsNote_textContext differentiates from other interfaces.
-	IsNote_textContext()
+	// IsNote_updatedContext differentiates from other interfaces.
+	IsNote_updatedContext()
 }
 
-type Note_textContext struct {
+type Note_updatedContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNote_textContext() *Note_textContext {
-	var p = new(Note_textContext)
+func NewEmptyNote_updatedContext() *Note_updatedContext {
+	var p = new(Note_updatedContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_text
+	p.RuleIndex = LatexParserRULE_note_updated
 	return p
 }
 
-func InitEmptyNote_textContext(p *Note_textContext)  {
+func InitEmptyNote_updatedContext(p *Note_updatedContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_note_text
+	p.RuleIndex = LatexParserRULE_note_updated
 }
 
-func (*Note_textContext) IsNote_textContext() {}
+func (*Note_updatedContext) IsNote_updatedContext() {}
 
-func NewNote_textContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_textContext {
-	var p = new(Note_textContext)
+func NewNote_updatedContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_updatedContext {
+	var p = new(Note_updatedContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_note_text
+	p.RuleIndex = LatexParserRULE_note_updated
 
 	return p
 }
 
-func (s *Note_textContext) GetParser() antlr.Parser { return s.parser }
+func (s *Note_updatedContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Note_textContext) AllText() []ITextContext {
+func (s *Note_updatedContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
+}
+
+func (s *Note_updatedContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *Note_updatedContext) AllWord() []IWordContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ITextContext); ok {
+		if _, ok := ctx.(IWordContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ITextContext, len)
+	tst := make([]IWordContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ITextContext); ok {
-			tst[i] = t.(ITextContext)
+		if t, ok := ctx.(IWordContext); ok {
+			tst[i] = t.(IWordContext)
 			i++
 		}
 	}
@@ -1526,11 +1697,11 @@ func (s *Note_textContext) AllText() []ITextContext {
 	return tst
 }
 
-func (s *Note_textContext) Text(i int) ITextContext {
+func (s *Note_updatedContext) Word(i int) IWordContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITextContext); ok {
+		if _, ok := ctx.(IWordContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -1543,23 +1714,238 @@ func (s *Note_textContext) Text(i int) ITextContext {
 		return nil
 	}
 
-	return t.(ITextContext)
+	return t.(IWordContext)
 }
 
-func (s *Note_textContext) AllBlock() []IBlockContext {
+func (s *Note_updatedContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, 0)
+}
+
+func (s *Note_updatedContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Note_updatedContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *Note_updatedContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterNote_updated(s)
+	}
+}
+
+func (s *Note_updatedContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitNote_updated(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Note_updated() (localctx INote_updatedContext) {
+	localctx = NewNote_updatedContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, LatexParserRULE_note_updated)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(114)
+		p.Match(LatexParserT__4)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(118)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(115)
+				p.Match(LatexParserWS)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+
+		}
+		p.SetState(120)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(122)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2869172371520) != 0) {
+		{
+			p.SetState(121)
+			p.Word()
+		}
+
+
+		p.SetState(124)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(126)
+		p.Match(LatexParserT__1)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(128)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == LatexParserNEWLINE {
+		{
+			p.SetState(127)
+			p.Match(LatexParserNEWLINE)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// INote_metadataContext is an interface to support dynamic dispatch.
+type INote_metadataContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	METADATA_KEY() antlr.TerminalNode
+	AllWS() []antlr.TerminalNode
+	WS(i int) antlr.TerminalNode
+	AllTag() []ITagContext
+	Tag(i int) ITagContext
+	AllCommand() []ICommandContext
+	Command(i int) ICommandContext
+	AllHref() []IHrefContext
+	Href(i int) IHrefContext
+	AllUrl() []IUrlContext
+	Url(i int) IUrlContext
+	AllMath() []IMathContext
+	Math(i int) IMathContext
+	AllWord() []IWordContext
+	Word(i int) IWordContext
+	NEWLINE() antlr.TerminalNode
+
+	// IsNote_metadataContext differentiates from other interfaces.
+	IsNote_metadataContext()
+}
+
+type Note_metadataContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNote_metadataContext() *Note_metadataContext {
+	var p = new(Note_metadataContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_note_metadata
+	return p
+}
+
+func InitEmptyNote_metadataContext(p *Note_metadataContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_note_metadata
+}
+
+func (*Note_metadataContext) IsNote_metadataContext() {}
+
+func NewNote_metadataContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_metadataContext {
+	var p = new(Note_metadataContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_note_metadata
+
+	return p
+}
+
+func (s *Note_metadataContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Note_metadataContext) METADATA_KEY() antlr.TerminalNode {
+	return s.GetToken(LatexParserMETADATA_KEY, 0)
+}
+
+func (s *Note_metadataContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
+}
+
+func (s *Note_metadataContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *Note_metadataContext) AllTag() []ITagContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IBlockContext); ok {
+		if _, ok := ctx.(ITagContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IBlockContext, len)
+	tst := make([]ITagContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IBlockContext); ok {
-			tst[i] = t.(IBlockContext)
+		if t, ok := ctx.(ITagContext); ok {
+			tst[i] = t.(ITagContext)
 			i++
 		}
 	}
@@ -1567,11 +1953,11 @@ func (s *Note_textContext) AllBlock() []IBlockContext {
 	return tst
 }
 
-func (s *Note_textContext) Block(i int) IBlockContext {
+func (s *Note_metadataContext) Tag(i int) ITagContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlockContext); ok {
+		if _, ok := ctx.(ITagContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -1584,23 +1970,23 @@ func (s *Note_textContext) Block(i int) IBlockContext {
 		return nil
 	}
 
-	return t.(IBlockContext)
+	return t.(ITagContext)
 }
 
-func (s *Note_textContext) AllLine_break() []ILine_breakContext {
+func (s *Note_metadataContext) AllCommand() []ICommandContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ILine_breakContext); ok {
+		if _, ok := ctx.(ICommandContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ILine_breakContext, len)
+	tst := make([]ICommandContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ILine_breakContext); ok {
-			tst[i] = t.(ILine_breakContext)
+		if t, ok := ctx.(ICommandContext); ok {
+			tst[i] = t.(ICommandContext)
 			i++
 		}
 	}
@@ -1608,11 +1994,11 @@ func (s *Note_textContext) AllLine_break() []ILine_breakContext {
 	return tst
 }
 
-func (s *Note_textContext) Line_break(i int) ILine_breakContext {
+func (s *Note_metadataContext) Command(i int) ICommandContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILine_breakContext); ok {
+		if _, ok := ctx.(ICommandContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -1625,23 +2011,23 @@ func (s *Note_textContext) Line_break(i int) ILine_breakContext {
 		return nil
 	}
 
-	return t.(ILine_breakContext)
+	return t.(ICommandContext)
 }
 
-func (s *Note_textContext) AllEmpty_line() []IEmpty_lineContext {
+func (s *Note_metadataContext) AllHref() []IHrefContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IEmpty_lineContext); ok {
+		if _, ok := ctx.(IHrefContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IEmpty_lineContext, len)
+	tst := make([]IHrefContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IEmpty_lineContext); ok {
-			tst[i] = t.(IEmpty_lineContext)
+		if t, ok := ctx.(IHrefContext); ok {
+			tst[i] = t.(IHrefContext)
 			i++
 		}
 	}
@@ -1649,11 +2035,11 @@ func (s *Note_textContext) AllEmpty_line() []IEmpty_lineContext {
 	return tst
 }
 
-func (s *Note_textContext) Empty_line(i int) IEmpty_lineContext {
+func (s *Note_metadataContext) Href(i int) IHrefContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEmpty_lineContext); ok {
+		if _, ok := ctx.(IHrefContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -1666,177 +2052,64 @@ func (s *Note_textContext) Empty_line(i int) IEmpty_lineContext {
 		return nil
 	}
 
-	return t.(IEmpty_lineContext)
-}
-
-func (s *Note_textContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *Note_textContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	return t.(IHrefContext)
 }
 
-
-func (s *Note_textContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterNote_text(s)
+func (s *Note_metadataContext) AllUrl() []IUrlContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUrlContext); ok {
+			len++
+		}
 	}
-}
 
-func (s *Note_textContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitNote_text(s)
+	tst := make([]IUrlContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUrlContext); ok {
+			tst[i] = t.(IUrlContext)
+			i++
+		}
 	}
+
+	return tst
 }
 
+func (s *Note_metadataContext) Url(i int) IUrlContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUrlContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
+	if t == nil {
+		return nil
+	}
 
+	return t.(IUrlContext)
+}
 
-func (p *LatexParser) Note_text() (localctx INote_textContext) {
-	localctx = NewNote_textContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, LatexParserRULE_note_text)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(113)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-
-	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3888260) != 0) {
-		p.SetState(111)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-
-		switch p.GetTokenStream().LA(1) {
-		case LatexParserT__6, LatexParserLETTER, LatexParserPUNCTUATION, LatexParserNUMBER, LatexParserWS:
-			{
-				p.SetState(107)
-				p.Text()
-			}
-
-
-		case LatexParserT__9, LatexParserT__11, LatexParserT__13:
-			{
-				p.SetState(108)
-				p.Block()
-			}
-
-
-		case LatexParserT__1:
-			{
-				p.SetState(109)
-				p.Line_break()
-			}
-
-
-		case LatexParserNEWLINE:
-			{
-				p.SetState(110)
-				p.Empty_line()
-			}
-
-
-
-		default:
-			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			goto errorExit
-		}
-
-		p.SetState(115)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-	    	goto errorExit
-	    }
-		_la = p.GetTokenStream().LA(1)
-	}
-
-
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-
-// ITextContext is an interface to support dynamic dispatch.
-type ITextContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	AllTag() []ITagContext
-	Tag(i int) ITagContext
-	AllWord() []IWordContext
-	Word(i int) IWordContext
-	NEWLINE() antlr.TerminalNode
-
-	// IsTextContext differentiates from other interfaces.
-	IsTextContext()
-}
-
-type TextContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyTextContext() *TextContext {
-	var p = new(TextContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_text
-	return p
-}
-
-func InitEmptyTextContext(p *TextContext)  {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_text
-}
-
-func (*TextContext) IsTextContext() {}
-
-func NewTextContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TextContext {
-	var p = new(TextContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = LatexParserRULE_text
-
-	return p
-}
-
-func (s *TextContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TextContext) AllTag() []ITagContext {
+func (s *Note_metadataContext) AllMath() []IMathContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ITagContext); ok {
+		if _, ok := ctx.(IMathContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ITagContext, len)
+	tst := make([]IMathContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ITagContext); ok {
-			tst[i] = t.(ITagContext)
+		if t, ok := ctx.(IMathContext); ok {
+			tst[i] = t.(IMathContext)
 			i++
 		}
 	}
@@ -1844,11 +2117,11 @@ func (s *TextContext) AllTag() []ITagContext {
 	return tst
 }
 
-func (s *TextContext) Tag(i int) ITagContext {
+func (s *Note_metadataContext) Math(i int) IMathContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITagContext); ok {
+		if _, ok := ctx.(IMathContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -1861,10 +2134,10 @@ func (s *TextContext) Tag(i int) ITagContext {
 		return nil
 	}
 
-	return t.(ITagContext)
+	return t.(IMathContext)
 }
 
-func (s *TextContext) AllWord() []IWordContext {
+func (s *Note_metadataContext) AllWord() []IWordContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -1885,7 +2158,7 @@ func (s *TextContext) AllWord() []IWordContext {
 	return tst
 }
 
-func (s *TextContext) Word(i int) IWordContext {
+func (s *Note_metadataContext) Word(i int) IWordContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -1905,94 +2178,169 @@ func (s *TextContext) Word(i int) IWordContext {
 	return t.(IWordContext)
 }
 
-func (s *TextContext) NEWLINE() antlr.TerminalNode {
+func (s *Note_metadataContext) NEWLINE() antlr.TerminalNode {
 	return s.GetToken(LatexParserNEWLINE, 0)
 }
 
-func (s *TextContext) GetRuleContext() antlr.RuleContext {
+func (s *Note_metadataContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TextContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Note_metadataContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *TextContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Note_metadataContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterText(s)
+		listenerT.EnterNote_metadata(s)
 	}
 }
 
-func (s *TextContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Note_metadataContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitText(s)
+		listenerT.ExitNote_metadata(s)
 	}
 }
 
 
 
 
-func (p *LatexParser) Text() (localctx ITextContext) {
-	localctx = NewTextContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, LatexParserRULE_text)
+func (p *LatexParser) Note_metadata() (localctx INote_metadataContext) {
+	localctx = NewNote_metadataContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, LatexParserRULE_note_metadata)
+	var _la int
+
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(118)
+	{
+		p.SetState(130)
+		p.Match(LatexParserMETADATA_KEY)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(134)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = 1
-	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		switch _alt {
-		case 1:
-				p.SetState(118)
-				p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(131)
+				p.Match(LatexParserWS)
 				if p.HasError() {
-					goto errorExit
+						// Recognition error - abort rule
+						goto errorExit
 				}
+			}
 
-				switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext()) {
-				case 1:
-					{
-						p.SetState(116)
-						p.Tag()
-					}
 
+		}
+		p.SetState(136)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(145)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-				case 2:
-					{
-						p.SetState(117)
-						p.Word()
-					}
 
-				case antlr.ATNInvalidAltNumber:
-					goto errorExit
-				}
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2885278502848) != 0) {
+		p.SetState(143)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
 
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 15, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(137)
+				p.Tag()
+			}
 
 
-		default:
-			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		case 2:
+			{
+				p.SetState(138)
+				p.Command()
+			}
+
+
+		case 3:
+			{
+				p.SetState(139)
+				p.Href()
+			}
+
+
+		case 4:
+			{
+				p.SetState(140)
+				p.Url()
+			}
+
+
+		case 5:
+			{
+				p.SetState(141)
+				p.Math()
+			}
+
+
+		case 6:
+			{
+				p.SetState(142)
+				p.Word()
+			}
+
+		case antlr.ATNInvalidAltNumber:
 			goto errorExit
 		}
 
-		p.SetState(120)
+		p.SetState(147)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 15, p.GetParserRuleContext())
 		if p.HasError() {
-			goto errorExit
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(148)
+		p.Match(LatexParserT__1)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 		}
 	}
-	p.SetState(123)
+	p.SetState(150)
 	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext()) == 1 {
+	if _la == LatexParserNEWLINE {
 		{
-			p.SetState(122)
+			p.SetState(149)
 			p.Match(LatexParserNEWLINE)
 			if p.HasError() {
 					// Recognition error - abort rule
@@ -2000,8 +2348,6 @@ func (p *LatexParser) Text() (localctx ITextContext) {
 			}
 		}
 
-		} else if p.HasError() { // JIM
-			goto errorExit
 	}
 
 
@@ -2020,251 +2366,295 @@ errorExit:
 }
 
 
-// ILine_breakContext is an interface to support dynamic dispatch.
-type ILine_breakContext interface {
+// INote_textContext is an interface to support dynamic dispatch.
+type INote_textContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllWS() []antlr.TerminalNode
-	WS(i int) antlr.TerminalNode
+	AllText() []ITextContext
+	Text(i int) ITextContext
+	AllBlock() []IBlockContext
+	Block(i int) IBlockContext
+	AllLine_break() []ILine_breakContext
+	Line_break(i int) ILine_breakContext
+	AllEmpty_line() []IEmpty_lineContext
+	Empty_line(i int) IEmpty_lineContext
 
-	// IsLine_breakContext differentiates from other interfaces.
-	IsLine_breakContext()
+	// IsNote_textContext differentiates from other interfaces.
+	IsNote_textContext()
 }
 
-type Line_breakContext struct {
+type Note_textContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLine_breakContext() *Line_breakContext {
-	var p = new(Line_breakContext)
+func NewEmptyNote_textContext() *Note_textContext {
+	var p = new(Note_textContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_line_break
+	p.RuleIndex = LatexParserRULE_note_text
 	return p
 }
 
-func InitEmptyLine_breakContext(p *Line_breakContext)  {
+func InitEmptyNote_textContext(p *Note_textContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_line_break
+	p.RuleIndex = LatexParserRULE_note_text
 }
 
-func (*Line_breakContext) IsLine_breakContext() {}
+func (*Note_textContext) IsNote_textContext() {}
 
-func NewLine_breakContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Line_breakContext {
-	var p = new(Line_breakContext)
+func NewNote_textContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Note_textContext {
+	var p = new(Note_textContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_line_break
+	p.RuleIndex = LatexParserRULE_note_text
 
 	return p
 }
 
-func (s *Line_breakContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Line_breakContext) AllWS() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserWS)
-}
+func (s *Note_textContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Line_breakContext) WS(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserWS, i)
-}
+func (s *Note_textContext) AllText() []ITextContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITextContext); ok {
+			len++
+		}
+	}
 
-func (s *Line_breakContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	tst := make([]ITextContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITextContext); ok {
+			tst[i] = t.(ITextContext)
+			i++
+		}
+	}
 
-func (s *Line_breakContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	return tst
 }
 
-
-func (s *Line_breakContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterLine_break(s)
+func (s *Note_textContext) Text(i int) ITextContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
 	}
-}
 
-func (s *Line_breakContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitLine_break(s)
+	if t == nil {
+		return nil
 	}
-}
 
+	return t.(ITextContext)
+}
 
+func (s *Note_textContext) AllBlock() []IBlockContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IBlockContext); ok {
+			len++
+		}
+	}
 
+	tst := make([]IBlockContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IBlockContext); ok {
+			tst[i] = t.(IBlockContext)
+			i++
+		}
+	}
 
-func (p *LatexParser) Line_break() (localctx ILine_breakContext) {
-	localctx = NewLine_breakContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, LatexParserRULE_line_break)
-	var _alt int
+	return tst
+}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(125)
-		p.Match(LatexParserT__1)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+func (s *Note_textContext) Block(i int) IBlockContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
 		}
 	}
-	p.SetState(129)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
+
+	if t == nil {
+		return nil
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(126)
-				p.Match(LatexParserWS)
-				if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-				}
-			}
 
+	return t.(IBlockContext)
+}
 
+func (s *Note_textContext) AllLine_break() []ILine_breakContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ILine_breakContext); ok {
+			len++
 		}
-		p.SetState(131)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-	    	goto errorExit
-	    }
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+	}
+
+	tst := make([]ILine_breakContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ILine_breakContext); ok {
+			tst[i] = t.(ILine_breakContext)
+			i++
 		}
 	}
 
+	return tst
+}
 
+func (s *Note_textContext) Line_break(i int) ILine_breakContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILine_breakContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	if t == nil {
+		return nil
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
+
+	return t.(ILine_breakContext)
 }
 
+func (s *Note_textContext) AllEmpty_line() []IEmpty_lineContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IEmpty_lineContext); ok {
+			len++
+		}
+	}
 
-// IEmpty_lineContext is an interface to support dynamic dispatch.
-type IEmpty_lineContext interface {
-	antlr.ParserRuleContext
+	tst := make([]IEmpty_lineContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IEmpty_lineContext); ok {
+			tst[i] = t.(IEmpty_lineContext)
+			i++
+		}
+	}
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	return tst
+}
 
-	// Getter signatures
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+func (s *Note_textContext) Empty_line(i int) IEmpty_lineContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEmpty_lineContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-	// IsEmpty_lineContext differentiates from other interfaces.
-	IsEmpty_lineContext()
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEmpty_lineContext)
 }
 
-type Empty_lineContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *Note_textContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func NewEmptyEmpty_lineContext() *Empty_lineContext {
-	var p = new(Empty_lineContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_empty_line
-	return p
+func (s *Note_textContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func InitEmptyEmpty_lineContext(p *Empty_lineContext)  {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_empty_line
+
+func (s *Note_textContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterNote_text(s)
+	}
 }
 
-func (*Empty_lineContext) IsEmpty_lineContext() {}
+func (s *Note_textContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitNote_text(s)
+	}
+}
 
-func NewEmpty_lineContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Empty_lineContext {
-	var p = new(Empty_lineContext)
 
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	p.parser = parser
-	p.RuleIndex = LatexParserRULE_empty_line
 
-	return p
-}
-
-func (s *Empty_lineContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Empty_lineContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserNEWLINE)
-}
-
-func (s *Empty_lineContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, i)
-}
-
-func (s *Empty_lineContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (p *LatexParser) Note_text() (localctx INote_textContext) {
+	localctx = NewNote_textContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, LatexParserRULE_note_text)
+	var _la int
 
-func (s *Empty_lineContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(158)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
 
-func (s *Empty_lineContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterEmpty_line(s)
-	}
-}
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3985080922052) != 0) {
+		p.SetState(156)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
 
-func (s *Empty_lineContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitEmpty_line(s)
-	}
-}
+		switch p.GetTokenStream().LA(1) {
+		case LatexParserT__5, LatexParserT__6, LatexParserT__7, LatexParserT__8, LatexParserT__9, LatexParserT__10, LatexParserT__12, LatexParserMETADATA_KEY, LatexParserURL, LatexParserHREF, LatexParserINLINE_MATH, LatexParserDISPLAY_MATH, LatexParserDOLLAR, LatexParserLETTER, LatexParserPUNCTUATION, LatexParserNUMBER, LatexParserWS:
+			{
+				p.SetState(152)
+				p.Text()
+			}
 
 
+		case LatexParserT__15, LatexParserT__17, LatexParserT__19, LatexParserT__21, LatexParserT__23, LatexParserTABULAR:
+			{
+				p.SetState(153)
+				p.Block()
+			}
 
 
-func (p *LatexParser) Empty_line() (localctx IEmpty_lineContext) {
-	localctx = NewEmpty_lineContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, LatexParserRULE_empty_line)
-	var _alt int
+		case LatexParserT__1:
+			{
+				p.SetState(154)
+				p.Line_break()
+			}
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(133)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = 1
-	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		switch _alt {
-		case 1:
-				{
-					p.SetState(132)
-					p.Match(LatexParserNEWLINE)
-					if p.HasError() {
-							// Recognition error - abort rule
-							goto errorExit
-					}
-				}
 
+		case LatexParserNEWLINE:
+			{
+				p.SetState(155)
+				p.Empty_line()
+			}
 
 
 
@@ -2273,12 +2663,12 @@ func (p *LatexParser) Empty_line() (localctx IEmpty_lineContext) {
 			goto errorExit
 		}
 
-		p.SetState(135)
+		p.SetState(160)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 18, p.GetParserRuleContext())
 		if p.HasError() {
-			goto errorExit
-		}
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
 	}
 
 
@@ -2297,329 +2687,313 @@ errorExit:
 }
 
 
-// IEscaped_wordContext is an interface to support dynamic dispatch.
-type IEscaped_wordContext interface {
+// ITextContext is an interface to support dynamic dispatch.
+type ITextContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetContent returns the content token.
-	GetContent() antlr.Token 
-
-	// GetSpace returns the space token.
-	GetSpace() antlr.Token 
-
-
-	// SetContent sets the content token.
-	SetContent(antlr.Token) 
-
-	// SetSpace sets the space token.
-	SetSpace(antlr.Token) 
-
-
 	// Getter signatures
+	AllTag() []ITagContext
+	Tag(i int) ITagContext
+	AllFootnote() []IFootnoteContext
+	Footnote(i int) IFootnoteContext
+	AllCommand() []ICommandContext
+	Command(i int) ICommandContext
+	AllHref() []IHrefContext
+	Href(i int) IHrefContext
+	AllUrl() []IUrlContext
+	Url(i int) IUrlContext
+	AllMath() []IMathContext
+	Math(i int) IMathContext
+	AllWord() []IWordContext
+	Word(i int) IWordContext
 	NEWLINE() antlr.TerminalNode
-	AllWS() []antlr.TerminalNode
-	WS(i int) antlr.TerminalNode
-	AllLETTER() []antlr.TerminalNode
-	LETTER(i int) antlr.TerminalNode
-	AllSYMBOL() []antlr.TerminalNode
-	SYMBOL(i int) antlr.TerminalNode
 
-	// IsEscaped_wordContext differentiates from other interfaces.
-	IsEscaped_wordContext()
+	// IsTextContext differentiates from other interfaces.
+	IsTextContext()
 }
 
-type Escaped_wordContext struct {
+type TextContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
-	content antlr.Token
-	space antlr.Token
 }
 
-func NewEmptyEscaped_wordContext() *Escaped_wordContext {
-	var p = new(Escaped_wordContext)
+func NewEmptyTextContext() *TextContext {
+	var p = new(TextContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_escaped_word
+	p.RuleIndex = LatexParserRULE_text
 	return p
 }
 
-func InitEmptyEscaped_wordContext(p *Escaped_wordContext)  {
+func InitEmptyTextContext(p *TextContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_escaped_word
+	p.RuleIndex = LatexParserRULE_text
 }
 
-func (*Escaped_wordContext) IsEscaped_wordContext() {}
+func (*TextContext) IsTextContext() {}
 
-func NewEscaped_wordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Escaped_wordContext {
-	var p = new(Escaped_wordContext)
+func NewTextContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TextContext {
+	var p = new(TextContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_escaped_word
+	p.RuleIndex = LatexParserRULE_text
 
 	return p
 }
 
-func (s *Escaped_wordContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Escaped_wordContext) GetContent() antlr.Token { return s.content }
+func (s *TextContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Escaped_wordContext) GetSpace() antlr.Token { return s.space }
+func (s *TextContext) AllTag() []ITagContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITagContext); ok {
+			len++
+		}
+	}
 
+	tst := make([]ITagContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITagContext); ok {
+			tst[i] = t.(ITagContext)
+			i++
+		}
+	}
 
-func (s *Escaped_wordContext) SetContent(v antlr.Token) { s.content = v }
+	return tst
+}
 
-func (s *Escaped_wordContext) SetSpace(v antlr.Token) { s.space = v }
+func (s *TextContext) Tag(i int) ITagContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
+	if t == nil {
+		return nil
+	}
 
-func (s *Escaped_wordContext) NEWLINE() antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, 0)
+	return t.(ITagContext)
 }
 
-func (s *Escaped_wordContext) AllWS() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserWS)
-}
+func (s *TextContext) AllFootnote() []IFootnoteContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFootnoteContext); ok {
+			len++
+		}
+	}
 
-func (s *Escaped_wordContext) WS(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserWS, i)
-}
+	tst := make([]IFootnoteContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFootnoteContext); ok {
+			tst[i] = t.(IFootnoteContext)
+			i++
+		}
+	}
 
-func (s *Escaped_wordContext) AllLETTER() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserLETTER)
+	return tst
 }
 
-func (s *Escaped_wordContext) LETTER(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserLETTER, i)
-}
+func (s *TextContext) Footnote(i int) IFootnoteContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFootnoteContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-func (s *Escaped_wordContext) AllSYMBOL() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserSYMBOL)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *Escaped_wordContext) SYMBOL(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserSYMBOL, i)
+	return t.(IFootnoteContext)
 }
 
-func (s *Escaped_wordContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *TextContext) AllCommand() []ICommandContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICommandContext); ok {
+			len++
+		}
+	}
 
-func (s *Escaped_wordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	tst := make([]ICommandContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICommandContext); ok {
+			tst[i] = t.(ICommandContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
+func (s *TextContext) Command(i int) ICommandContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICommandContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-func (s *Escaped_wordContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterEscaped_word(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(ICommandContext)
 }
 
-func (s *Escaped_wordContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitEscaped_word(s)
+func (s *TextContext) AllHref() []IHrefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IHrefContext); ok {
+			len++
+		}
 	}
-}
-
-
 
-
-func (p *LatexParser) Escaped_word() (localctx IEscaped_wordContext) {
-	localctx = NewEscaped_wordContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, LatexParserRULE_escaped_word)
-	var _la int
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(137)
-		p.Match(LatexParserT__6)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+	tst := make([]IHrefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IHrefContext); ok {
+			tst[i] = t.(IHrefContext)
+			i++
 		}
 	}
-	p.SetState(139)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = 1
-	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		switch _alt {
-		case 1:
-				{
-					p.SetState(138)
 
-					var _lt = p.GetTokenStream().LT(1)
-
-					localctx.(*Escaped_wordContext).content = _lt
-
-					_la = p.GetTokenStream().LA(1)
-
-					if !(_la == LatexParserLETTER || _la == LatexParserSYMBOL) {
-						var _ri = p.GetErrorHandler().RecoverInline(p)
-
-						localctx.(*Escaped_wordContext).content = _ri
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-
-
-
-
-		default:
-			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			goto errorExit
-		}
+	return tst
+}
 
-		p.SetState(141)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 19, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+func (s *TextContext) Href(i int) IHrefContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IHrefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
 		}
 	}
-	p.SetState(146)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(143)
-
-				var _m = p.Match(LatexParserWS)
 
-				localctx.(*Escaped_wordContext).space = _m
-				if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-				}
-			}
+	if t == nil {
+		return nil
+	}
 
+	return t.(IHrefContext)
+}
 
+func (s *TextContext) AllUrl() []IUrlContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUrlContext); ok {
+			len++
 		}
-		p.SetState(148)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-	    	goto errorExit
-	    }
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+	}
+
+	tst := make([]IUrlContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUrlContext); ok {
+			tst[i] = t.(IUrlContext)
+			i++
 		}
 	}
-	p.SetState(150)
-	p.GetErrorHandler().Sync(p)
 
+	return tst
+}
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(149)
-			p.Match(LatexParserNEWLINE)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
+func (s *TextContext) Url(i int) IUrlContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUrlContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
 			}
+			j++
 		}
-
-		} else if p.HasError() { // JIM
-			goto errorExit
 	}
 
-
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	if t == nil {
+		return nil
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-
-// ITagContext is an interface to support dynamic dispatch.
-type ITagContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// GetName returns the name token.
-	GetName() antlr.Token 
-
-
-	// SetName sets the name token.
-	SetName(antlr.Token) 
-
-
-	// Getter signatures
-	AllWord() []IWordContext
-	Word(i int) IWordContext
-	AllLETTER() []antlr.TerminalNode
-	LETTER(i int) antlr.TerminalNode
 
-	// IsTagContext differentiates from other interfaces.
-	IsTagContext()
+	return t.(IUrlContext)
 }
 
-type TagContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-	name antlr.Token
-}
+func (s *TextContext) AllMath() []IMathContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMathContext); ok {
+			len++
+		}
+	}
 
-func NewEmptyTagContext() *TagContext {
-	var p = new(TagContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_tag
-	return p
-}
+	tst := make([]IMathContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMathContext); ok {
+			tst[i] = t.(IMathContext)
+			i++
+		}
+	}
 
-func InitEmptyTagContext(p *TagContext)  {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_tag
+	return tst
 }
 
-func (*TagContext) IsTagContext() {}
-
-func NewTagContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagContext {
-	var p = new(TagContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+func (s *TextContext) Math(i int) IMathContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMathContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-	p.parser = parser
-	p.RuleIndex = LatexParserRULE_tag
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IMathContext)
 }
 
-func (s *TagContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TagContext) GetName() antlr.Token { return s.name }
-
-
-func (s *TagContext) SetName(v antlr.Token) { s.name = v }
-
-
-func (s *TagContext) AllWord() []IWordContext {
+func (s *TextContext) AllWord() []IWordContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -2640,7 +3014,7 @@ func (s *TagContext) AllWord() []IWordContext {
 	return tst
 }
 
-func (s *TagContext) Word(i int) IWordContext {
+func (s *TextContext) Word(i int) IWordContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -2660,118 +3034,138 @@ func (s *TagContext) Word(i int) IWordContext {
 	return t.(IWordContext)
 }
 
-func (s *TagContext) AllLETTER() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserLETTER)
-}
-
-func (s *TagContext) LETTER(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserLETTER, i)
+func (s *TextContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, 0)
 }
 
-func (s *TagContext) GetRuleContext() antlr.RuleContext {
+func (s *TextContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TagContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TextContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *TagContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TextContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterTag(s)
+		listenerT.EnterText(s)
 	}
 }
 
-func (s *TagContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TextContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitTag(s)
+		listenerT.ExitText(s)
 	}
 }
 
 
 
 
-func (p *LatexParser) Tag() (localctx ITagContext) {
-	localctx = NewTagContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, LatexParserRULE_tag)
-	var _la int
+func (p *LatexParser) Text() (localctx ITextContext) {
+	localctx = NewTextContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, LatexParserRULE_text)
+	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(152)
-		p.Match(LatexParserT__6)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-		}
-	}
-	p.SetState(154)
+	p.SetState(168)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
-
+	_alt = 1
+	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		switch _alt {
+		case 1:
+				p.SetState(168)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
 
-	for ok := true; ok; ok = _la == LatexParserLETTER {
-		{
-			p.SetState(153)
+				switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext()) {
+				case 1:
+					{
+						p.SetState(161)
+						p.Tag()
+					}
 
-			var _m = p.Match(LatexParserLETTER)
 
-			localctx.(*TagContext).name = _m
-			if p.HasError() {
-					// Recognition error - abort rule
+				case 2:
+					{
+						p.SetState(162)
+						p.Footnote()
+					}
+
+
+				case 3:
+					{
+						p.SetState(163)
+						p.Command()
+					}
+
+
+				case 4:
+					{
+						p.SetState(164)
+						p.Href()
+					}
+
+
+				case 5:
+					{
+						p.SetState(165)
+						p.Url()
+					}
+
+
+				case 6:
+					{
+						p.SetState(166)
+						p.Math()
+					}
+
+
+				case 7:
+					{
+						p.SetState(167)
+						p.Word()
+					}
+
+				case antlr.ATNInvalidAltNumber:
 					goto errorExit
-			}
-		}
+				}
 
 
-		p.SetState(156)
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+
+		p.SetState(170)
 		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext())
 		if p.HasError() {
-	    	goto errorExit
-	    }
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(158)
-		p.Match(LatexParserT__7)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+			goto errorExit
 		}
 	}
-	p.SetState(160)
+	p.SetState(173)
 	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
 
-	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2818176) != 0) {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 22, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(159)
-			p.Word()
+			p.SetState(172)
+			p.Match(LatexParserNEWLINE)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
 		}
 
-
-		p.SetState(162)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-	    	goto errorExit
-	    }
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(164)
-		p.Match(LatexParserT__3)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-		}
+		} else if p.HasError() { // JIM
+			goto errorExit
 	}
 
 
@@ -2790,334 +3184,269 @@ errorExit:
 }
 
 
-// IWordContext is an interface to support dynamic dispatch.
-type IWordContext interface {
+// ILine_breakContext is an interface to support dynamic dispatch.
+type ILine_breakContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsWordContext differentiates from other interfaces.
-	IsWordContext()
+
+	// Getter signatures
+	AllWS() []antlr.TerminalNode
+	WS(i int) antlr.TerminalNode
+
+	// IsLine_breakContext differentiates from other interfaces.
+	IsLine_breakContext()
 }
 
-type WordContext struct {
+type Line_breakContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWordContext() *WordContext {
-	var p = new(WordContext)
+func NewEmptyLine_breakContext() *Line_breakContext {
+	var p = new(Line_breakContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_word
+	p.RuleIndex = LatexParserRULE_line_break
 	return p
 }
 
-func InitEmptyWordContext(p *WordContext)  {
+func InitEmptyLine_breakContext(p *Line_breakContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_word
+	p.RuleIndex = LatexParserRULE_line_break
 }
 
-func (*WordContext) IsWordContext() {}
+func (*Line_breakContext) IsLine_breakContext() {}
 
-func NewWordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WordContext {
-	var p = new(WordContext)
+func NewLine_breakContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Line_breakContext {
+	var p = new(Line_breakContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_word
+	p.RuleIndex = LatexParserRULE_line_break
 
 	return p
 }
 
-func (s *WordContext) GetParser() antlr.Parser { return s.parser }
+func (s *Line_breakContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WordContext) CopyAll(ctx *WordContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
+func (s *Line_breakContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
 }
 
-func (s *WordContext) GetRuleContext() antlr.RuleContext {
+func (s *Line_breakContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *Line_breakContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Line_breakContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
+func (s *Line_breakContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterLine_break(s)
+	}
+}
 
-
-type EscapedContext struct {
-	WordContext
+func (s *Line_breakContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitLine_break(s)
+	}
 }
 
-func NewEscapedContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EscapedContext {
-	var p = new(EscapedContext)
 
-	InitEmptyWordContext(&p.WordContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*WordContext))
 
-	return p
-}
 
-func (s *EscapedContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (p *LatexParser) Line_break() (localctx ILine_breakContext) {
+	localctx = NewLine_breakContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, LatexParserRULE_line_break)
+	var _alt int
 
-func (s *EscapedContext) Escaped_word() IEscaped_wordContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEscaped_wordContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(175)
+		p.Match(LatexParserT__1)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 		}
 	}
+	p.SetState(179)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 23, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(176)
+				p.Match(LatexParserWS)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
 
-	if t == nil {
-		return nil
+
+		}
+		p.SetState(181)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 23, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 
-	return t.(IEscaped_wordContext)
-}
 
 
-func (s *EscapedContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterEscaped(s)
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (s *EscapedContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitEscaped(s)
-	}
-}
 
+// IEmpty_lineContext is an interface to support dynamic dispatch.
+type IEmpty_lineContext interface {
+	antlr.ParserRuleContext
 
-type NumberContext struct {
-	WordContext
-}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-func NewNumberContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NumberContext {
-	var p = new(NumberContext)
+	// Getter signatures
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	InitEmptyWordContext(&p.WordContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*WordContext))
+	// IsEmpty_lineContext differentiates from other interfaces.
+	IsEmpty_lineContext()
+}
 
-	return p
+type Empty_lineContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *NumberContext) GetRuleContext() antlr.RuleContext {
-	return s
+func NewEmptyEmpty_lineContext() *Empty_lineContext {
+	var p = new(Empty_lineContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_empty_line
+	return p
 }
 
-func (s *NumberContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(LatexParserNUMBER, 0)
+func InitEmptyEmpty_lineContext(p *Empty_lineContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_empty_line
 }
 
+func (*Empty_lineContext) IsEmpty_lineContext() {}
 
-func (s *NumberContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterNumber(s)
-	}
-}
-
-func (s *NumberContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitNumber(s)
-	}
-}
-
-
-type LetterContext struct {
-	WordContext
-}
+func NewEmpty_lineContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Empty_lineContext {
+	var p = new(Empty_lineContext)
 
-func NewLetterContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LetterContext {
-	var p = new(LetterContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	InitEmptyWordContext(&p.WordContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*WordContext))
+	p.RuleIndex = LatexParserRULE_empty_line
 
 	return p
 }
 
-func (s *LetterContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *LetterContext) LETTER() antlr.TerminalNode {
-	return s.GetToken(LatexParserLETTER, 0)
-}
-
-
-func (s *LetterContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterLetter(s)
-	}
-}
-
-func (s *LetterContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitLetter(s)
-	}
-}
-
+func (s *Empty_lineContext) GetParser() antlr.Parser { return s.parser }
 
-type PunctuationContext struct {
-	WordContext
+func (s *Empty_lineContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserNEWLINE)
 }
 
-func NewPunctuationContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PunctuationContext {
-	var p = new(PunctuationContext)
-
-	InitEmptyWordContext(&p.WordContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*WordContext))
-
-	return p
+func (s *Empty_lineContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, i)
 }
 
-func (s *PunctuationContext) GetRuleContext() antlr.RuleContext {
+func (s *Empty_lineContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PunctuationContext) PUNCTUATION() antlr.TerminalNode {
-	return s.GetToken(LatexParserPUNCTUATION, 0)
+func (s *Empty_lineContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *PunctuationContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Empty_lineContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterPunctuation(s)
+		listenerT.EnterEmpty_line(s)
 	}
 }
 
-func (s *PunctuationContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Empty_lineContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitPunctuation(s)
+		listenerT.ExitEmpty_line(s)
 	}
 }
 
 
-type WsContext struct {
-	WordContext
-}
-
-func NewWsContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *WsContext {
-	var p = new(WsContext)
-
-	InitEmptyWordContext(&p.WordContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*WordContext))
-
-	return p
-}
-
-func (s *WsContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *WsContext) WS() antlr.TerminalNode {
-	return s.GetToken(LatexParserWS, 0)
-}
-
-
-func (s *WsContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterWs(s)
-	}
-}
-
-func (s *WsContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitWs(s)
-	}
-}
 
 
+func (p *LatexParser) Empty_line() (localctx IEmpty_lineContext) {
+	localctx = NewEmpty_lineContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, LatexParserRULE_empty_line)
+	var _alt int
 
-func (p *LatexParser) Word() (localctx IWordContext) {
-	localctx = NewWordContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, LatexParserRULE_word)
-	p.SetState(171)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(183)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-
-	switch p.GetTokenStream().LA(1) {
-	case LatexParserT__6:
-		localctx = NewEscapedContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(166)
-			p.Escaped_word()
-		}
-
-
-	case LatexParserLETTER:
-		localctx = NewLetterContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(167)
-			p.Match(LatexParserLETTER)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-			}
-		}
+	_alt = 1
+	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		switch _alt {
+		case 1:
+				{
+					p.SetState(182)
+					p.Match(LatexParserNEWLINE)
+					if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+					}
+				}
 
 
-	case LatexParserPUNCTUATION:
-		localctx = NewPunctuationContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(168)
-			p.Match(LatexParserPUNCTUATION)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-			}
-		}
 
 
-	case LatexParserNUMBER:
-		localctx = NewNumberContext(p, localctx)
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(169)
-			p.Match(LatexParserNUMBER)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-			}
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
-
-	case LatexParserWS:
-		localctx = NewWsContext(p, localctx)
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(170)
-			p.Match(LatexParserWS)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-			}
+		p.SetState(185)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
 		}
-
-
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 
+
 errorExit:
 	if p.HasError() {
 		v := p.GetError()
@@ -3132,244 +3461,260 @@ errorExit:
 }
 
 
-// IVerbatim_contentContext is an interface to support dynamic dispatch.
-type IVerbatim_contentContext interface {
+// IEscaped_wordContext is an interface to support dynamic dispatch.
+type IEscaped_wordContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsVerbatim_contentContext differentiates from other interfaces.
-	IsVerbatim_contentContext()
+
+	// GetContent returns the content token.
+	GetContent() antlr.Token 
+
+	// GetSpace returns the space token.
+	GetSpace() antlr.Token 
+
+
+	// SetContent sets the content token.
+	SetContent(antlr.Token) 
+
+	// SetSpace sets the space token.
+	SetSpace(antlr.Token) 
+
+
+	// Getter signatures
+	NEWLINE() antlr.TerminalNode
+	AllWS() []antlr.TerminalNode
+	WS(i int) antlr.TerminalNode
+	AllLETTER() []antlr.TerminalNode
+	LETTER(i int) antlr.TerminalNode
+	AllSYMBOL() []antlr.TerminalNode
+	SYMBOL(i int) antlr.TerminalNode
+	AllAMPERSAND() []antlr.TerminalNode
+	AMPERSAND(i int) antlr.TerminalNode
+
+	// IsEscaped_wordContext differentiates from other interfaces.
+	IsEscaped_wordContext()
 }
 
-type Verbatim_contentContext struct {
+type Escaped_wordContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
+	content antlr.Token
+	space antlr.Token
 }
 
-func NewEmptyVerbatim_contentContext() *Verbatim_contentContext {
-	var p = new(Verbatim_contentContext)
+func NewEmptyEscaped_wordContext() *Escaped_wordContext {
+	var p = new(Escaped_wordContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_verbatim_content
+	p.RuleIndex = LatexParserRULE_escaped_word
 	return p
 }
 
-func InitEmptyVerbatim_contentContext(p *Verbatim_contentContext)  {
+func InitEmptyEscaped_wordContext(p *Escaped_wordContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_verbatim_content
+	p.RuleIndex = LatexParserRULE_escaped_word
 }
 
-func (*Verbatim_contentContext) IsVerbatim_contentContext() {}
+func (*Escaped_wordContext) IsEscaped_wordContext() {}
 
-func NewVerbatim_contentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Verbatim_contentContext {
-	var p = new(Verbatim_contentContext)
+func NewEscaped_wordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Escaped_wordContext {
+	var p = new(Escaped_wordContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_verbatim_content
+	p.RuleIndex = LatexParserRULE_escaped_word
 
 	return p
 }
 
-func (s *Verbatim_contentContext) GetParser() antlr.Parser { return s.parser }
+func (s *Escaped_wordContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Verbatim_contentContext) CopyAll(ctx *Verbatim_contentContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
-}
+func (s *Escaped_wordContext) GetContent() antlr.Token { return s.content }
 
-func (s *Verbatim_contentContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *Escaped_wordContext) GetSpace() antlr.Token { return s.space }
 
-func (s *Verbatim_contentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
 
+func (s *Escaped_wordContext) SetContent(v antlr.Token) { s.content = v }
 
+func (s *Escaped_wordContext) SetSpace(v antlr.Token) { s.space = v }
 
 
-type Verbatim_wordContext struct {
-	Verbatim_contentContext
-}
-
-func NewVerbatim_wordContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Verbatim_wordContext {
-	var p = new(Verbatim_wordContext)
-
-	InitEmptyVerbatim_contentContext(&p.Verbatim_contentContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*Verbatim_contentContext))
-
-	return p
+func (s *Escaped_wordContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, 0)
 }
 
-func (s *Verbatim_wordContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *Escaped_wordContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
 }
 
-func (s *Verbatim_wordContext) Word() IWordContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWordContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IWordContext)
+func (s *Escaped_wordContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
 }
 
-
-func (s *Verbatim_wordContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterVerbatim_word(s)
-	}
+func (s *Escaped_wordContext) AllLETTER() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserLETTER)
 }
 
-func (s *Verbatim_wordContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitVerbatim_word(s)
-	}
+func (s *Escaped_wordContext) LETTER(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserLETTER, i)
 }
 
-
-type Verbatim_symbolContext struct {
-	Verbatim_contentContext
+func (s *Escaped_wordContext) AllSYMBOL() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserSYMBOL)
 }
 
-func NewVerbatim_symbolContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Verbatim_symbolContext {
-	var p = new(Verbatim_symbolContext)
+func (s *Escaped_wordContext) SYMBOL(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserSYMBOL, i)
+}
 
-	InitEmptyVerbatim_contentContext(&p.Verbatim_contentContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*Verbatim_contentContext))
+func (s *Escaped_wordContext) AllAMPERSAND() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserAMPERSAND)
+}
 
-	return p
+func (s *Escaped_wordContext) AMPERSAND(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserAMPERSAND, i)
 }
 
-func (s *Verbatim_symbolContext) GetRuleContext() antlr.RuleContext {
+func (s *Escaped_wordContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Verbatim_symbolContext) SYMBOL() antlr.TerminalNode {
-	return s.GetToken(LatexParserSYMBOL, 0)
+func (s *Escaped_wordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *Verbatim_symbolContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Escaped_wordContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterVerbatim_symbol(s)
+		listenerT.EnterEscaped_word(s)
 	}
 }
 
-func (s *Verbatim_symbolContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Escaped_wordContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitVerbatim_symbol(s)
+		listenerT.ExitEscaped_word(s)
 	}
 }
 
 
-type Verbatim_linebreakContext struct {
-	Verbatim_contentContext
-}
-
-func NewVerbatim_linebreakContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Verbatim_linebreakContext {
-	var p = new(Verbatim_linebreakContext)
 
-	InitEmptyVerbatim_contentContext(&p.Verbatim_contentContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*Verbatim_contentContext))
 
-	return p
-}
+func (p *LatexParser) Escaped_word() (localctx IEscaped_wordContext) {
+	localctx = NewEscaped_wordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, LatexParserRULE_escaped_word)
+	var _la int
 
-func (s *Verbatim_linebreakContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	var _alt int
 
-func (s *Verbatim_linebreakContext) Line_break() ILine_breakContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILine_breakContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(187)
+		p.Match(LatexParserT__5)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 		}
 	}
-
-	if t == nil {
-		return nil
+	p.SetState(189)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
+	_alt = 1
+	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		switch _alt {
+		case 1:
+				{
+					p.SetState(188)
 
-	return t.(ILine_breakContext)
-}
+					var _lt = p.GetTokenStream().LT(1)
 
+					localctx.(*Escaped_wordContext).content = _lt
 
-func (s *Verbatim_linebreakContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterVerbatim_linebreak(s)
-	}
-}
+					_la = p.GetTokenStream().LA(1)
 
-func (s *Verbatim_linebreakContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitVerbatim_linebreak(s)
-	}
-}
+					if !(((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 446676598784) != 0)) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*Escaped_wordContext).content = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
 
 
 
-func (p *LatexParser) Verbatim_content() (localctx IVerbatim_contentContext) {
-	localctx = NewVerbatim_contentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, LatexParserRULE_verbatim_content)
-	p.SetState(176)
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+
+		p.SetState(191)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 25, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(196)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 26, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(193)
 
-	switch p.GetTokenStream().LA(1) {
-	case LatexParserT__6, LatexParserLETTER, LatexParserPUNCTUATION, LatexParserNUMBER, LatexParserWS:
-		localctx = NewVerbatim_wordContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(173)
-			p.Word()
+				var _m = p.Match(LatexParserWS)
+
+				localctx.(*Escaped_wordContext).space = _m
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+
+		}
+		p.SetState(198)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 26, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
 		}
+	}
+	p.SetState(200)
+	p.GetErrorHandler().Sync(p)
 
 
-	case LatexParserSYMBOL:
-		localctx = NewVerbatim_symbolContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 27, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(174)
-			p.Match(LatexParserSYMBOL)
+			p.SetState(199)
+			p.Match(LatexParserNEWLINE)
 			if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 			}
 		}
 
-
-	case LatexParserT__1:
-		localctx = NewVerbatim_linebreakContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(175)
-			p.Line_break()
-		}
-
-
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
+		} else if p.HasError() { // JIM
+			goto errorExit
 	}
 
 
+
 errorExit:
 	if p.HasError() {
 		v := p.GetError()
@@ -3384,72 +3729,96 @@ errorExit:
 }
 
 
-// IVerbatim_lineContext is an interface to support dynamic dispatch.
-type IVerbatim_lineContext interface {
+// ITagContext is an interface to support dynamic dispatch.
+type ITagContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
+	// GetName returns the name token.
+	GetName() antlr.Token 
+
+
+	// SetName sets the name token.
+	SetName(antlr.Token) 
+
+
 	// Getter signatures
-	NEWLINE() antlr.TerminalNode
-	AllVerbatim_content() []IVerbatim_contentContext
-	Verbatim_content(i int) IVerbatim_contentContext
+	AllTag() []ITagContext
+	Tag(i int) ITagContext
+	AllFootnote() []IFootnoteContext
+	Footnote(i int) IFootnoteContext
+	AllCommand() []ICommandContext
+	Command(i int) ICommandContext
+	AllHref() []IHrefContext
+	Href(i int) IHrefContext
+	AllUrl() []IUrlContext
+	Url(i int) IUrlContext
+	AllMath() []IMathContext
+	Math(i int) IMathContext
+	AllWord() []IWordContext
+	Word(i int) IWordContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsVerbatim_lineContext differentiates from other interfaces.
-	IsVerbatim_lineContext()
+	// IsTagContext differentiates from other interfaces.
+	IsTagContext()
 }
 
-type Verbatim_lineContext struct {
+type TagContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
+	name antlr.Token
 }
 
-func NewEmptyVerbatim_lineContext() *Verbatim_lineContext {
-	var p = new(Verbatim_lineContext)
+func NewEmptyTagContext() *TagContext {
+	var p = new(TagContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_verbatim_line
+	p.RuleIndex = LatexParserRULE_tag
 	return p
 }
 
-func InitEmptyVerbatim_lineContext(p *Verbatim_lineContext)  {
+func InitEmptyTagContext(p *TagContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_verbatim_line
+	p.RuleIndex = LatexParserRULE_tag
 }
 
-func (*Verbatim_lineContext) IsVerbatim_lineContext() {}
+func (*TagContext) IsTagContext() {}
 
-func NewVerbatim_lineContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Verbatim_lineContext {
-	var p = new(Verbatim_lineContext)
+func NewTagContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagContext {
+	var p = new(TagContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_verbatim_line
+	p.RuleIndex = LatexParserRULE_tag
 
 	return p
 }
 
-func (s *Verbatim_lineContext) GetParser() antlr.Parser { return s.parser }
+func (s *TagContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Verbatim_lineContext) NEWLINE() antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, 0)
-}
+func (s *TagContext) GetName() antlr.Token { return s.name }
 
-func (s *Verbatim_lineContext) AllVerbatim_content() []IVerbatim_contentContext {
-	children := s.GetChildren()
+
+func (s *TagContext) SetName(v antlr.Token) { s.name = v }
+
+
+func (s *TagContext) AllTag() []ITagContext {
+	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IVerbatim_contentContext); ok {
+		if _, ok := ctx.(ITagContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IVerbatim_contentContext, len)
+	tst := make([]ITagContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IVerbatim_contentContext); ok {
-			tst[i] = t.(IVerbatim_contentContext)
+		if t, ok := ctx.(ITagContext); ok {
+			tst[i] = t.(ITagContext)
 			i++
 		}
 	}
@@ -3457,11 +3826,11 @@ func (s *Verbatim_lineContext) AllVerbatim_content() []IVerbatim_contentContext
 	return tst
 }
 
-func (s *Verbatim_lineContext) Verbatim_content(i int) IVerbatim_contentContext {
+func (s *TagContext) Tag(i int) ITagContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IVerbatim_contentContext); ok {
+		if _, ok := ctx.(ITagContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -3474,136 +3843,215 @@ func (s *Verbatim_lineContext) Verbatim_content(i int) IVerbatim_contentContext
 		return nil
 	}
 
-	return t.(IVerbatim_contentContext)
+	return t.(ITagContext)
 }
 
-func (s *Verbatim_lineContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *TagContext) AllFootnote() []IFootnoteContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFootnoteContext); ok {
+			len++
+		}
+	}
 
-func (s *Verbatim_lineContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+	tst := make([]IFootnoteContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFootnoteContext); ok {
+			tst[i] = t.(IFootnoteContext)
+			i++
+		}
+	}
 
+	return tst
+}
 
-func (s *Verbatim_lineContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterVerbatim_line(s)
+func (s *TagContext) Footnote(i int) IFootnoteContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFootnoteContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
 	}
-}
 
-func (s *Verbatim_lineContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitVerbatim_line(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(IFootnoteContext)
 }
 
+func (s *TagContext) AllCommand() []ICommandContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICommandContext); ok {
+			len++
+		}
+	}
 
+	tst := make([]ICommandContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICommandContext); ok {
+			tst[i] = t.(ICommandContext)
+			i++
+		}
+	}
 
+	return tst
+}
 
-func (p *LatexParser) Verbatim_line() (localctx IVerbatim_lineContext) {
-	localctx = NewVerbatim_lineContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, LatexParserRULE_verbatim_line)
-	var _la int
+func (s *TagContext) Command(i int) ICommandContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICommandContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(181)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	if t == nil {
+		return nil
 	}
-	_la = p.GetTokenStream().LA(1)
 
+	return t.(ICommandContext)
+}
 
-	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3080324) != 0) {
-		{
-			p.SetState(178)
-			p.Verbatim_content()
+func (s *TagContext) AllHref() []IHrefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IHrefContext); ok {
+			len++
 		}
-
-
-		p.SetState(183)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-	    	goto errorExit
-	    }
-		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(184)
-		p.Match(LatexParserNEWLINE)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+
+	tst := make([]IHrefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IHrefContext); ok {
+			tst[i] = t.(IHrefContext)
+			i++
 		}
 	}
 
+	return tst
+}
 
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+func (s *TagContext) Href(i int) IHrefContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IHrefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
 
+	if t == nil {
+		return nil
+	}
 
-// IBlock_lineContext is an interface to support dynamic dispatch.
-type IBlock_lineContext interface {
-	antlr.ParserRuleContext
+	return t.(IHrefContext)
+}
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+func (s *TagContext) AllUrl() []IUrlContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUrlContext); ok {
+			len++
+		}
+	}
 
-	// Getter signatures
-	AllWord() []IWordContext
-	Word(i int) IWordContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+	tst := make([]IUrlContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUrlContext); ok {
+			tst[i] = t.(IUrlContext)
+			i++
+		}
+	}
 
-	// IsBlock_lineContext differentiates from other interfaces.
-	IsBlock_lineContext()
+	return tst
 }
 
-type Block_lineContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
+func (s *TagContext) Url(i int) IUrlContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUrlContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-func NewEmptyBlock_lineContext() *Block_lineContext {
-	var p = new(Block_lineContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_block_line
-	return p
-}
+	if t == nil {
+		return nil
+	}
 
-func InitEmptyBlock_lineContext(p *Block_lineContext)  {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_block_line
+	return t.(IUrlContext)
 }
 
-func (*Block_lineContext) IsBlock_lineContext() {}
+func (s *TagContext) AllMath() []IMathContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMathContext); ok {
+			len++
+		}
+	}
 
-func NewBlock_lineContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Block_lineContext {
-	var p = new(Block_lineContext)
+	tst := make([]IMathContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMathContext); ok {
+			tst[i] = t.(IMathContext)
+			i++
+		}
+	}
 
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+	return tst
+}
 
-	p.parser = parser
-	p.RuleIndex = LatexParserRULE_block_line
+func (s *TagContext) Math(i int) IMathContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMathContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-	return p
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *Block_lineContext) GetParser() antlr.Parser { return s.parser }
+	return t.(IMathContext)
+}
 
-func (s *Block_lineContext) AllWord() []IWordContext {
+func (s *TagContext) AllWord() []IWordContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -3619,119 +4067,3700 @@ func (s *Block_lineContext) AllWord() []IWordContext {
 			tst[i] = t.(IWordContext)
 			i++
 		}
-	}
+	}
+
+	return tst
+}
+
+func (s *TagContext) Word(i int) IWordContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWordContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWordContext)
+}
+
+func (s *TagContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserNEWLINE)
+}
+
+func (s *TagContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, i)
+}
+
+func (s *TagContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TagContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *TagContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterTag(s)
+	}
+}
+
+func (s *TagContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitTag(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Tag() (localctx ITagContext) {
+	localctx = NewTagContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, LatexParserRULE_tag)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(202)
+
+		var _lt = p.GetTokenStream().LT(1)
+
+		localctx.(*TagContext).name = _lt
+
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3968) != 0)) {
+			var _ri = p.GetErrorHandler().RecoverInline(p)
+
+			localctx.(*TagContext).name = _ri
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	p.SetState(213)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3984790138816) != 0) {
+		p.SetState(211)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 28, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(203)
+				p.Tag()
+			}
+
+
+		case 2:
+			{
+				p.SetState(204)
+				p.Footnote()
+			}
+
+
+		case 3:
+			{
+				p.SetState(205)
+				p.Command()
+			}
+
+
+		case 4:
+			{
+				p.SetState(206)
+				p.Href()
+			}
+
+
+		case 5:
+			{
+				p.SetState(207)
+				p.Url()
+			}
+
+
+		case 6:
+			{
+				p.SetState(208)
+				p.Math()
+			}
+
+
+		case 7:
+			{
+				p.SetState(209)
+				p.Word()
+			}
+
+
+		case 8:
+			{
+				p.SetState(210)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+		case antlr.ATNInvalidAltNumber:
+			goto errorExit
+		}
+
+		p.SetState(215)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(216)
+		p.Match(LatexParserT__11)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IFootnoteContext is an interface to support dynamic dispatch.
+type IFootnoteContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllTag() []ITagContext
+	Tag(i int) ITagContext
+	AllCommand() []ICommandContext
+	Command(i int) ICommandContext
+	AllHref() []IHrefContext
+	Href(i int) IHrefContext
+	AllUrl() []IUrlContext
+	Url(i int) IUrlContext
+	AllMath() []IMathContext
+	Math(i int) IMathContext
+	AllWord() []IWordContext
+	Word(i int) IWordContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+
+	// IsFootnoteContext differentiates from other interfaces.
+	IsFootnoteContext()
+}
+
+type FootnoteContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFootnoteContext() *FootnoteContext {
+	var p = new(FootnoteContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_footnote
+	return p
+}
+
+func InitEmptyFootnoteContext(p *FootnoteContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_footnote
+}
+
+func (*FootnoteContext) IsFootnoteContext() {}
+
+func NewFootnoteContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FootnoteContext {
+	var p = new(FootnoteContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_footnote
+
+	return p
+}
+
+func (s *FootnoteContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FootnoteContext) AllTag() []ITagContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITagContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITagContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITagContext); ok {
+			tst[i] = t.(ITagContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FootnoteContext) Tag(i int) ITagContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagContext)
+}
+
+func (s *FootnoteContext) AllCommand() []ICommandContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICommandContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICommandContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICommandContext); ok {
+			tst[i] = t.(ICommandContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FootnoteContext) Command(i int) ICommandContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICommandContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICommandContext)
+}
+
+func (s *FootnoteContext) AllHref() []IHrefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IHrefContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IHrefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IHrefContext); ok {
+			tst[i] = t.(IHrefContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FootnoteContext) Href(i int) IHrefContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IHrefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IHrefContext)
+}
+
+func (s *FootnoteContext) AllUrl() []IUrlContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUrlContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IUrlContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUrlContext); ok {
+			tst[i] = t.(IUrlContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FootnoteContext) Url(i int) IUrlContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUrlContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUrlContext)
+}
+
+func (s *FootnoteContext) AllMath() []IMathContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMathContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMathContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMathContext); ok {
+			tst[i] = t.(IMathContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FootnoteContext) Math(i int) IMathContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMathContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMathContext)
+}
+
+func (s *FootnoteContext) AllWord() []IWordContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IWordContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IWordContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IWordContext); ok {
+			tst[i] = t.(IWordContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FootnoteContext) Word(i int) IWordContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWordContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWordContext)
+}
+
+func (s *FootnoteContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserNEWLINE)
+}
+
+func (s *FootnoteContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, i)
+}
+
+func (s *FootnoteContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FootnoteContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *FootnoteContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterFootnote(s)
+	}
+}
+
+func (s *FootnoteContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitFootnote(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Footnote() (localctx IFootnoteContext) {
+	localctx = NewFootnoteContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, LatexParserRULE_footnote)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(218)
+		p.Match(LatexParserT__12)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(228)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3984790130624) != 0) {
+		p.SetState(226)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(219)
+				p.Tag()
+			}
+
+
+		case 2:
+			{
+				p.SetState(220)
+				p.Command()
+			}
+
+
+		case 3:
+			{
+				p.SetState(221)
+				p.Href()
+			}
+
+
+		case 4:
+			{
+				p.SetState(222)
+				p.Url()
+			}
+
+
+		case 5:
+			{
+				p.SetState(223)
+				p.Math()
+			}
+
+
+		case 6:
+			{
+				p.SetState(224)
+				p.Word()
+			}
+
+
+		case 7:
+			{
+				p.SetState(225)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+		case antlr.ATNInvalidAltNumber:
+			goto errorExit
+		}
+
+		p.SetState(230)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(231)
+		p.Match(LatexParserT__11)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// ICommandContext is an interface to support dynamic dispatch.
+type ICommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetName returns the name token.
+	GetName() antlr.Token 
+
+
+	// SetName sets the name token.
+	SetName(antlr.Token) 
+
+
+	// Getter signatures
+	AllWord() []IWordContext
+	Word(i int) IWordContext
+	AllLETTER() []antlr.TerminalNode
+	LETTER(i int) antlr.TerminalNode
+
+	// IsCommandContext differentiates from other interfaces.
+	IsCommandContext()
+}
+
+type CommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+	name antlr.Token
+}
+
+func NewEmptyCommandContext() *CommandContext {
+	var p = new(CommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_command
+	return p
+}
+
+func InitEmptyCommandContext(p *CommandContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_command
+}
+
+func (*CommandContext) IsCommandContext() {}
+
+func NewCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CommandContext {
+	var p = new(CommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_command
+
+	return p
+}
+
+func (s *CommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CommandContext) GetName() antlr.Token { return s.name }
+
+
+func (s *CommandContext) SetName(v antlr.Token) { s.name = v }
+
+
+func (s *CommandContext) AllWord() []IWordContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IWordContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IWordContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IWordContext); ok {
+			tst[i] = t.(IWordContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CommandContext) Word(i int) IWordContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWordContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWordContext)
+}
+
+func (s *CommandContext) AllLETTER() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserLETTER)
+}
+
+func (s *CommandContext) LETTER(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserLETTER, i)
+}
+
+func (s *CommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *CommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterCommand(s)
+	}
+}
+
+func (s *CommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitCommand(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Command() (localctx ICommandContext) {
+	localctx = NewCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, LatexParserRULE_command)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(233)
+		p.Match(LatexParserT__5)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(235)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	for ok := true; ok; ok = _la == LatexParserLETTER {
+		{
+			p.SetState(234)
+
+			var _m = p.Match(LatexParserLETTER)
+
+			localctx.(*CommandContext).name = _m
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+		p.SetState(237)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(239)
+		p.Match(LatexParserT__13)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(241)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2869172371520) != 0) {
+		{
+			p.SetState(240)
+			p.Word()
+		}
+
+
+		p.SetState(243)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(245)
+		p.Match(LatexParserT__11)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IHrefContext is an interface to support dynamic dispatch.
+type IHrefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	HREF() antlr.TerminalNode
+	AllTag() []ITagContext
+	Tag(i int) ITagContext
+	AllCommand() []ICommandContext
+	Command(i int) ICommandContext
+	AllUrl() []IUrlContext
+	Url(i int) IUrlContext
+	AllMath() []IMathContext
+	Math(i int) IMathContext
+	AllWord() []IWordContext
+	Word(i int) IWordContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+
+	// IsHrefContext differentiates from other interfaces.
+	IsHrefContext()
+}
+
+type HrefContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyHrefContext() *HrefContext {
+	var p = new(HrefContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_href
+	return p
+}
+
+func InitEmptyHrefContext(p *HrefContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_href
+}
+
+func (*HrefContext) IsHrefContext() {}
+
+func NewHrefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HrefContext {
+	var p = new(HrefContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_href
+
+	return p
+}
+
+func (s *HrefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *HrefContext) HREF() antlr.TerminalNode {
+	return s.GetToken(LatexParserHREF, 0)
+}
+
+func (s *HrefContext) AllTag() []ITagContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITagContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITagContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITagContext); ok {
+			tst[i] = t.(ITagContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *HrefContext) Tag(i int) ITagContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagContext)
+}
+
+func (s *HrefContext) AllCommand() []ICommandContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICommandContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICommandContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICommandContext); ok {
+			tst[i] = t.(ICommandContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *HrefContext) Command(i int) ICommandContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICommandContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICommandContext)
+}
+
+func (s *HrefContext) AllUrl() []IUrlContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUrlContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IUrlContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUrlContext); ok {
+			tst[i] = t.(IUrlContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *HrefContext) Url(i int) IUrlContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUrlContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUrlContext)
+}
+
+func (s *HrefContext) AllMath() []IMathContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMathContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMathContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMathContext); ok {
+			tst[i] = t.(IMathContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *HrefContext) Math(i int) IMathContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMathContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMathContext)
+}
+
+func (s *HrefContext) AllWord() []IWordContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IWordContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IWordContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IWordContext); ok {
+			tst[i] = t.(IWordContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *HrefContext) Word(i int) IWordContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWordContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWordContext)
+}
+
+func (s *HrefContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserNEWLINE)
+}
+
+func (s *HrefContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, i)
+}
+
+func (s *HrefContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *HrefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *HrefContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterHref(s)
+	}
+}
+
+func (s *HrefContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitHref(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Href() (localctx IHrefContext) {
+	localctx = NewHrefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, LatexParserRULE_href)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(247)
+		p.Match(LatexParserHREF)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	{
+		p.SetState(248)
+		p.Match(LatexParserT__13)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	p.SetState(257)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3982642646976) != 0) {
+		p.SetState(255)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(249)
+				p.Tag()
+			}
+
+
+		case 2:
+			{
+				p.SetState(250)
+				p.Command()
+			}
+
+
+		case 3:
+			{
+				p.SetState(251)
+				p.Url()
+			}
+
+
+		case 4:
+			{
+				p.SetState(252)
+				p.Math()
+			}
+
+
+		case 5:
+			{
+				p.SetState(253)
+				p.Word()
+			}
+
+
+		case 6:
+			{
+				p.SetState(254)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+		case antlr.ATNInvalidAltNumber:
+			goto errorExit
+		}
+
+		p.SetState(259)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(260)
+		p.Match(LatexParserT__11)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IUrlContext is an interface to support dynamic dispatch.
+type IUrlContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	URL() antlr.TerminalNode
+
+	// IsUrlContext differentiates from other interfaces.
+	IsUrlContext()
+}
+
+type UrlContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUrlContext() *UrlContext {
+	var p = new(UrlContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_url
+	return p
+}
+
+func InitEmptyUrlContext(p *UrlContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_url
+}
+
+func (*UrlContext) IsUrlContext() {}
+
+func NewUrlContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UrlContext {
+	var p = new(UrlContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_url
+
+	return p
+}
+
+func (s *UrlContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UrlContext) URL() antlr.TerminalNode {
+	return s.GetToken(LatexParserURL, 0)
+}
+
+func (s *UrlContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UrlContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *UrlContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterUrl(s)
+	}
+}
+
+func (s *UrlContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitUrl(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Url() (localctx IUrlContext) {
+	localctx = NewUrlContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, LatexParserRULE_url)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(262)
+		p.Match(LatexParserURL)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IMathContext is an interface to support dynamic dispatch.
+type IMathContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsMathContext differentiates from other interfaces.
+	IsMathContext()
+}
+
+type MathContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMathContext() *MathContext {
+	var p = new(MathContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_math
+	return p
+}
+
+func InitEmptyMathContext(p *MathContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_math
+}
+
+func (*MathContext) IsMathContext() {}
+
+func NewMathContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MathContext {
+	var p = new(MathContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_math
+
+	return p
+}
+
+func (s *MathContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MathContext) CopyAll(ctx *MathContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *MathContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MathContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+
+
+type Inline_mathContext struct {
+	MathContext
+}
+
+func NewInline_mathContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Inline_mathContext {
+	var p = new(Inline_mathContext)
+
+	InitEmptyMathContext(&p.MathContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*MathContext))
+
+	return p
+}
+
+func (s *Inline_mathContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Inline_mathContext) INLINE_MATH() antlr.TerminalNode {
+	return s.GetToken(LatexParserINLINE_MATH, 0)
+}
+
+
+func (s *Inline_mathContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterInline_math(s)
+	}
+}
+
+func (s *Inline_mathContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitInline_math(s)
+	}
+}
+
+
+type Display_mathContext struct {
+	MathContext
+}
+
+func NewDisplay_mathContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Display_mathContext {
+	var p = new(Display_mathContext)
+
+	InitEmptyMathContext(&p.MathContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*MathContext))
+
+	return p
+}
+
+func (s *Display_mathContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Display_mathContext) DISPLAY_MATH() antlr.TerminalNode {
+	return s.GetToken(LatexParserDISPLAY_MATH, 0)
+}
+
+
+func (s *Display_mathContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterDisplay_math(s)
+	}
+}
+
+func (s *Display_mathContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitDisplay_math(s)
+	}
+}
+
+
+
+func (p *LatexParser) Math() (localctx IMathContext) {
+	localctx = NewMathContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, LatexParserRULE_math)
+	p.SetState(266)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case LatexParserINLINE_MATH:
+		localctx = NewInline_mathContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(264)
+			p.Match(LatexParserINLINE_MATH)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+	case LatexParserDISPLAY_MATH:
+		localctx = NewDisplay_mathContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(265)
+			p.Match(LatexParserDISPLAY_MATH)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IWordContext is an interface to support dynamic dispatch.
+type IWordContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsWordContext differentiates from other interfaces.
+	IsWordContext()
+}
+
+type WordContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWordContext() *WordContext {
+	var p = new(WordContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_word
+	return p
+}
+
+func InitEmptyWordContext(p *WordContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_word
+}
+
+func (*WordContext) IsWordContext() {}
+
+func NewWordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WordContext {
+	var p = new(WordContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_word
+
+	return p
+}
+
+func (s *WordContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WordContext) CopyAll(ctx *WordContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *WordContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+
+
+type EscapedContext struct {
+	WordContext
+}
+
+func NewEscapedContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EscapedContext {
+	var p = new(EscapedContext)
+
+	InitEmptyWordContext(&p.WordContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*WordContext))
+
+	return p
+}
+
+func (s *EscapedContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EscapedContext) Escaped_word() IEscaped_wordContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEscaped_wordContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEscaped_wordContext)
+}
+
+
+func (s *EscapedContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterEscaped(s)
+	}
+}
+
+func (s *EscapedContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitEscaped(s)
+	}
+}
+
+
+type NumberContext struct {
+	WordContext
+}
+
+func NewNumberContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NumberContext {
+	var p = new(NumberContext)
+
+	InitEmptyWordContext(&p.WordContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*WordContext))
+
+	return p
+}
+
+func (s *NumberContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NumberContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(LatexParserNUMBER, 0)
+}
+
+
+func (s *NumberContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterNumber(s)
+	}
+}
+
+func (s *NumberContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitNumber(s)
+	}
+}
+
+
+type LetterContext struct {
+	WordContext
+}
+
+func NewLetterContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LetterContext {
+	var p = new(LetterContext)
+
+	InitEmptyWordContext(&p.WordContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*WordContext))
+
+	return p
+}
+
+func (s *LetterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LetterContext) LETTER() antlr.TerminalNode {
+	return s.GetToken(LatexParserLETTER, 0)
+}
+
+
+func (s *LetterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterLetter(s)
+	}
+}
+
+func (s *LetterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitLetter(s)
+	}
+}
+
+
+type PunctuationContext struct {
+	WordContext
+}
+
+func NewPunctuationContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PunctuationContext {
+	var p = new(PunctuationContext)
+
+	InitEmptyWordContext(&p.WordContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*WordContext))
+
+	return p
+}
+
+func (s *PunctuationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PunctuationContext) PUNCTUATION() antlr.TerminalNode {
+	return s.GetToken(LatexParserPUNCTUATION, 0)
+}
+
+
+func (s *PunctuationContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterPunctuation(s)
+	}
+}
+
+func (s *PunctuationContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitPunctuation(s)
+	}
+}
+
+
+type LabelContext struct {
+	WordContext
+}
+
+func NewLabelContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LabelContext {
+	var p = new(LabelContext)
+
+	InitEmptyWordContext(&p.WordContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*WordContext))
+
+	return p
+}
+
+func (s *LabelContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LabelContext) METADATA_KEY() antlr.TerminalNode {
+	return s.GetToken(LatexParserMETADATA_KEY, 0)
+}
+
+
+func (s *LabelContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterLabel(s)
+	}
+}
+
+func (s *LabelContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitLabel(s)
+	}
+}
+
+
+type WsContext struct {
+	WordContext
+}
+
+func NewWsContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *WsContext {
+	var p = new(WsContext)
+
+	InitEmptyWordContext(&p.WordContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*WordContext))
+
+	return p
+}
+
+func (s *WsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WsContext) WS() antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, 0)
+}
+
+
+func (s *WsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterWs(s)
+	}
+}
+
+func (s *WsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitWs(s)
+	}
+}
+
+
+type DollarContext struct {
+	WordContext
+}
+
+func NewDollarContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DollarContext {
+	var p = new(DollarContext)
+
+	InitEmptyWordContext(&p.WordContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*WordContext))
+
+	return p
+}
+
+func (s *DollarContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DollarContext) DOLLAR() antlr.TerminalNode {
+	return s.GetToken(LatexParserDOLLAR, 0)
+}
+
+
+func (s *DollarContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterDollar(s)
+	}
+}
+
+func (s *DollarContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitDollar(s)
+	}
+}
+
+
+
+func (p *LatexParser) Word() (localctx IWordContext) {
+	localctx = NewWordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, LatexParserRULE_word)
+	p.SetState(275)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case LatexParserT__5:
+		localctx = NewEscapedContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(268)
+			p.Escaped_word()
+		}
+
+
+	case LatexParserDOLLAR:
+		localctx = NewDollarContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(269)
+			p.Match(LatexParserDOLLAR)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+	case LatexParserMETADATA_KEY:
+		localctx = NewLabelContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(270)
+			p.Match(LatexParserMETADATA_KEY)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+	case LatexParserLETTER:
+		localctx = NewLetterContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(271)
+			p.Match(LatexParserLETTER)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+	case LatexParserPUNCTUATION:
+		localctx = NewPunctuationContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(272)
+			p.Match(LatexParserPUNCTUATION)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+	case LatexParserNUMBER:
+		localctx = NewNumberContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(273)
+			p.Match(LatexParserNUMBER)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+	case LatexParserWS:
+		localctx = NewWsContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(274)
+			p.Match(LatexParserWS)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IVerbatim_contentContext is an interface to support dynamic dispatch.
+type IVerbatim_contentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsVerbatim_contentContext differentiates from other interfaces.
+	IsVerbatim_contentContext()
+}
+
+type Verbatim_contentContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVerbatim_contentContext() *Verbatim_contentContext {
+	var p = new(Verbatim_contentContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_verbatim_content
+	return p
+}
+
+func InitEmptyVerbatim_contentContext(p *Verbatim_contentContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_verbatim_content
+}
+
+func (*Verbatim_contentContext) IsVerbatim_contentContext() {}
+
+func NewVerbatim_contentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Verbatim_contentContext {
+	var p = new(Verbatim_contentContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_verbatim_content
+
+	return p
+}
+
+func (s *Verbatim_contentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Verbatim_contentContext) CopyAll(ctx *Verbatim_contentContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *Verbatim_contentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Verbatim_contentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+
+
+type Verbatim_wordContext struct {
+	Verbatim_contentContext
+}
+
+func NewVerbatim_wordContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Verbatim_wordContext {
+	var p = new(Verbatim_wordContext)
+
+	InitEmptyVerbatim_contentContext(&p.Verbatim_contentContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*Verbatim_contentContext))
+
+	return p
+}
+
+func (s *Verbatim_wordContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Verbatim_wordContext) Word() IWordContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWordContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWordContext)
+}
+
+
+func (s *Verbatim_wordContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterVerbatim_word(s)
+	}
+}
+
+func (s *Verbatim_wordContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitVerbatim_word(s)
+	}
+}
+
+
+type Verbatim_symbolContext struct {
+	Verbatim_contentContext
+}
+
+func NewVerbatim_symbolContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Verbatim_symbolContext {
+	var p = new(Verbatim_symbolContext)
+
+	InitEmptyVerbatim_contentContext(&p.Verbatim_contentContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*Verbatim_contentContext))
+
+	return p
+}
+
+func (s *Verbatim_symbolContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Verbatim_symbolContext) SYMBOL() antlr.TerminalNode {
+	return s.GetToken(LatexParserSYMBOL, 0)
+}
+
+
+func (s *Verbatim_symbolContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterVerbatim_symbol(s)
+	}
+}
+
+func (s *Verbatim_symbolContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitVerbatim_symbol(s)
+	}
+}
+
+
+type Verbatim_linebreakContext struct {
+	Verbatim_contentContext
+}
+
+func NewVerbatim_linebreakContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Verbatim_linebreakContext {
+	var p = new(Verbatim_linebreakContext)
+
+	InitEmptyVerbatim_contentContext(&p.Verbatim_contentContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*Verbatim_contentContext))
+
+	return p
+}
+
+func (s *Verbatim_linebreakContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Verbatim_linebreakContext) Line_break() ILine_breakContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILine_breakContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILine_breakContext)
+}
+
+
+func (s *Verbatim_linebreakContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterVerbatim_linebreak(s)
+	}
+}
+
+func (s *Verbatim_linebreakContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitVerbatim_linebreak(s)
+	}
+}
+
+
+
+func (p *LatexParser) Verbatim_content() (localctx IVerbatim_contentContext) {
+	localctx = NewVerbatim_contentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, LatexParserRULE_verbatim_content)
+	p.SetState(280)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case LatexParserT__5, LatexParserMETADATA_KEY, LatexParserDOLLAR, LatexParserLETTER, LatexParserPUNCTUATION, LatexParserNUMBER, LatexParserWS:
+		localctx = NewVerbatim_wordContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(277)
+			p.Word()
+		}
+
+
+	case LatexParserSYMBOL:
+		localctx = NewVerbatim_symbolContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(278)
+			p.Match(LatexParserSYMBOL)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+
+
+	case LatexParserT__1:
+		localctx = NewVerbatim_linebreakContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(279)
+			p.Line_break()
+		}
+
+
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IVerbatim_lineContext is an interface to support dynamic dispatch.
+type IVerbatim_lineContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	NEWLINE() antlr.TerminalNode
+	AllVerbatim_content() []IVerbatim_contentContext
+	Verbatim_content(i int) IVerbatim_contentContext
+
+	// IsVerbatim_lineContext differentiates from other interfaces.
+	IsVerbatim_lineContext()
+}
+
+type Verbatim_lineContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVerbatim_lineContext() *Verbatim_lineContext {
+	var p = new(Verbatim_lineContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_verbatim_line
+	return p
+}
+
+func InitEmptyVerbatim_lineContext(p *Verbatim_lineContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_verbatim_line
+}
+
+func (*Verbatim_lineContext) IsVerbatim_lineContext() {}
+
+func NewVerbatim_lineContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Verbatim_lineContext {
+	var p = new(Verbatim_lineContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_verbatim_line
+
+	return p
+}
+
+func (s *Verbatim_lineContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Verbatim_lineContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, 0)
+}
+
+func (s *Verbatim_lineContext) AllVerbatim_content() []IVerbatim_contentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IVerbatim_contentContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IVerbatim_contentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IVerbatim_contentContext); ok {
+			tst[i] = t.(IVerbatim_contentContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Verbatim_lineContext) Verbatim_content(i int) IVerbatim_contentContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVerbatim_contentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVerbatim_contentContext)
+}
+
+func (s *Verbatim_lineContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Verbatim_lineContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *Verbatim_lineContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterVerbatim_line(s)
+	}
+}
+
+func (s *Verbatim_lineContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitVerbatim_line(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Verbatim_line() (localctx IVerbatim_lineContext) {
+	localctx = NewVerbatim_lineContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, LatexParserRULE_verbatim_line)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(285)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3144050278468) != 0) {
+		{
+			p.SetState(282)
+			p.Verbatim_content()
+		}
+
+
+		p.SetState(287)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(288)
+		p.Match(LatexParserNEWLINE)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IBlock_itemContext is an interface to support dynamic dispatch.
+type IBlock_itemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Note_text() INote_textContext
+
+	// IsBlock_itemContext differentiates from other interfaces.
+	IsBlock_itemContext()
+}
+
+type Block_itemContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBlock_itemContext() *Block_itemContext {
+	var p = new(Block_itemContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_block_item
+	return p
+}
+
+func InitEmptyBlock_itemContext(p *Block_itemContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_block_item
+}
+
+func (*Block_itemContext) IsBlock_itemContext() {}
+
+func NewBlock_itemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Block_itemContext {
+	var p = new(Block_itemContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_block_item
+
+	return p
+}
+
+func (s *Block_itemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Block_itemContext) Note_text() INote_textContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INote_textContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INote_textContext)
+}
+
+func (s *Block_itemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Block_itemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *Block_itemContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterBlock_item(s)
+	}
+}
+
+func (s *Block_itemContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitBlock_item(s)
+	}
+}
+
+
+
+
+func (p *LatexParser) Block_item() (localctx IBlock_itemContext) {
+	localctx = NewBlock_itemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, LatexParserRULE_block_item)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(290)
+		p.Match(LatexParserT__14)
+		if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+		}
+	}
+	{
+		p.SetState(291)
+		p.Note_text()
+	}
+
+
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+
+// IBlockContext is an interface to support dynamic dispatch.
+type IBlockContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsBlockContext differentiates from other interfaces.
+	IsBlockContext()
+}
+
+type BlockContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBlockContext() *BlockContext {
+	var p = new(BlockContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_block
+	return p
+}
+
+func InitEmptyBlockContext(p *BlockContext)  {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = LatexParserRULE_block
+}
+
+func (*BlockContext) IsBlockContext() {}
+
+func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
+	var p = new(BlockContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_block
+
+	return p
+}
+
+func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BlockContext) CopyAll(ctx *BlockContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *BlockContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+
+
+type ItemizeContext struct {
+	BlockContext
+}
+
+func NewItemizeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ItemizeContext {
+	var p = new(ItemizeContext)
+
+	InitEmptyBlockContext(&p.BlockContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*BlockContext))
+
+	return p
+}
+
+func (s *ItemizeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ItemizeContext) AllBlock_item() []IBlock_itemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IBlock_itemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IBlock_itemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IBlock_itemContext); ok {
+			tst[i] = t.(IBlock_itemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ItemizeContext) Block_item(i int) IBlock_itemContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlock_itemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlock_itemContext)
+}
+
+func (s *ItemizeContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
+}
+
+func (s *ItemizeContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *ItemizeContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserNEWLINE)
+}
+
+func (s *ItemizeContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, i)
+}
+
+
+func (s *ItemizeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterItemize(s)
+	}
+}
+
+func (s *ItemizeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitItemize(s)
+	}
+}
+
+
+type QuoteContext struct {
+	BlockContext
+}
+
+func NewQuoteContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *QuoteContext {
+	var p = new(QuoteContext)
+
+	InitEmptyBlockContext(&p.BlockContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*BlockContext))
+
+	return p
+}
+
+func (s *QuoteContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QuoteContext) Note_text() INote_textContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INote_textContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INote_textContext)
+}
+
+func (s *QuoteContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
+}
+
+func (s *QuoteContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *QuoteContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, 0)
+}
+
+
+func (s *QuoteContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterQuote(s)
+	}
+}
+
+func (s *QuoteContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitQuote(s)
+	}
+}
+
+
+type TabularContext struct {
+	BlockContext
+}
+
+func NewTabularContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TabularContext {
+	var p = new(TabularContext)
+
+	InitEmptyBlockContext(&p.BlockContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*BlockContext))
+
+	return p
+}
+
+func (s *TabularContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TabularContext) TABULAR() antlr.TerminalNode {
+	return s.GetToken(LatexParserTABULAR, 0)
+}
+
+func (s *TabularContext) AllTable_row() []ITable_rowContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITable_rowContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITable_rowContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITable_rowContext); ok {
+			tst[i] = t.(ITable_rowContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TabularContext) Table_row(i int) ITable_rowContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITable_rowContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITable_rowContext)
+}
+
+func (s *TabularContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
+}
+
+func (s *TabularContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *TabularContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, 0)
+}
+
+
+func (s *TabularContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterTabular(s)
+	}
+}
+
+func (s *TabularContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitTabular(s)
+	}
+}
+
+
+type EnumerateContext struct {
+	BlockContext
+}
+
+func NewEnumerateContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EnumerateContext {
+	var p = new(EnumerateContext)
+
+	InitEmptyBlockContext(&p.BlockContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*BlockContext))
+
+	return p
+}
+
+func (s *EnumerateContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EnumerateContext) AllBlock_item() []IBlock_itemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IBlock_itemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IBlock_itemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IBlock_itemContext); ok {
+			tst[i] = t.(IBlock_itemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EnumerateContext) Block_item(i int) IBlock_itemContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlock_itemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlock_itemContext)
+}
+
+func (s *EnumerateContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
+}
+
+func (s *EnumerateContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *EnumerateContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserNEWLINE)
+}
+
+func (s *EnumerateContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, i)
+}
+
+
+func (s *EnumerateContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterEnumerate(s)
+	}
+}
+
+func (s *EnumerateContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitEnumerate(s)
+	}
+}
+
+
+type QuotationContext struct {
+	BlockContext
+}
+
+func NewQuotationContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *QuotationContext {
+	var p = new(QuotationContext)
+
+	InitEmptyBlockContext(&p.BlockContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*BlockContext))
+
+	return p
+}
+
+func (s *QuotationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QuotationContext) Note_text() INote_textContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INote_textContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INote_textContext)
+}
+
+func (s *QuotationContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserWS)
+}
+
+func (s *QuotationContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserWS, i)
+}
+
+func (s *QuotationContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, 0)
+}
+
+
+func (s *QuotationContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterQuotation(s)
+	}
+}
+
+func (s *QuotationContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitQuotation(s)
+	}
+}
+
+
+type VerbatimContext struct {
+	BlockContext
+}
+
+func NewVerbatimContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VerbatimContext {
+	var p = new(VerbatimContext)
+
+	InitEmptyBlockContext(&p.BlockContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*BlockContext))
+
+	return p
+}
+
+func (s *VerbatimContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VerbatimContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserNEWLINE)
+}
+
+func (s *VerbatimContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, i)
+}
+
+func (s *VerbatimContext) AllVerbatim_line() []IVerbatim_lineContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IVerbatim_lineContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IVerbatim_lineContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IVerbatim_lineContext); ok {
+			tst[i] = t.(IVerbatim_lineContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *VerbatimContext) Verbatim_line(i int) IVerbatim_lineContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVerbatim_lineContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVerbatim_lineContext)
+}
+
+
+func (s *VerbatimContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.EnterVerbatim(s)
+	}
+}
+
+func (s *VerbatimContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(LatexListener); ok {
+		listenerT.ExitVerbatim(s)
+	}
+}
+
+
+
+func (p *LatexParser) Block() (localctx IBlockContext) {
+	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, LatexParserRULE_block)
+	var _la int
+
+	var _alt int
+
+	p.SetState(397)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case LatexParserT__15:
+		localctx = NewItemizeContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(293)
+			p.Match(LatexParserT__15)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(297)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+
+		for _la == LatexParserNEWLINE || _la == LatexParserWS {
+			{
+				p.SetState(294)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == LatexParserNEWLINE || _la == LatexParserWS) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+
+
+			p.SetState(299)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(303)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+
+		for _la == LatexParserT__14 {
+			{
+				p.SetState(300)
+				p.Block_item()
+			}
+
+
+			p.SetState(305)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(306)
+			p.Match(LatexParserT__16)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(310)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(307)
+					p.Match(LatexParserWS)
+					if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+					}
+				}
+
+
+			}
+			p.SetState(312)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(314)
+		p.GetErrorHandler().Sync(p)
+
+
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 43, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(313)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+			} else if p.HasError() { // JIM
+				goto errorExit
+		}
+
+
+	case LatexParserT__17:
+		localctx = NewEnumerateContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(316)
+			p.Match(LatexParserT__17)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(320)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+
+		for _la == LatexParserNEWLINE || _la == LatexParserWS {
+			{
+				p.SetState(317)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == LatexParserNEWLINE || _la == LatexParserWS) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+
+
+			p.SetState(322)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(326)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+
+		for _la == LatexParserT__14 {
+			{
+				p.SetState(323)
+				p.Block_item()
+			}
+
+
+			p.SetState(328)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(329)
+			p.Match(LatexParserT__18)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(333)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 46, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(330)
+					p.Match(LatexParserWS)
+					if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+					}
+				}
+
+
+			}
+			p.SetState(335)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 46, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(337)
+		p.GetErrorHandler().Sync(p)
+
+
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(336)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+			} else if p.HasError() { // JIM
+				goto errorExit
+		}
+
+
+	case LatexParserT__19:
+		localctx = NewQuoteContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(339)
+			p.Match(LatexParserT__19)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		{
+			p.SetState(340)
+			p.Note_text()
+		}
+		{
+			p.SetState(341)
+			p.Match(LatexParserT__20)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(345)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 48, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(342)
+					p.Match(LatexParserWS)
+					if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+					}
+				}
+
+
+			}
+			p.SetState(347)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 48, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(349)
+		p.GetErrorHandler().Sync(p)
+
+
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 49, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(348)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+			} else if p.HasError() { // JIM
+				goto errorExit
+		}
 
-	return tst
-}
 
-func (s *Block_lineContext) Word(i int) IWordContext {
-	var t antlr.RuleContext;
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWordContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext);
-				break
+	case LatexParserT__21:
+		localctx = NewQuotationContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(351)
+			p.Match(LatexParserT__21)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
 			}
-			j++
 		}
-	}
+		{
+			p.SetState(352)
+			p.Note_text()
+		}
+		{
+			p.SetState(353)
+			p.Match(LatexParserT__22)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(357)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 50, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(354)
+					p.Match(LatexParserWS)
+					if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+					}
+				}
 
-	if t == nil {
-		return nil
-	}
 
-	return t.(IWordContext)
-}
+			}
+			p.SetState(359)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 50, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(361)
+		p.GetErrorHandler().Sync(p)
 
-func (s *Block_lineContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserNEWLINE)
-}
 
-func (s *Block_lineContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, i)
-}
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 51, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(360)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
 
-func (s *Block_lineContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+			} else if p.HasError() { // JIM
+				goto errorExit
+		}
 
-func (s *Block_lineContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
 
+	case LatexParserT__23:
+		localctx = NewVerbatimContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(363)
+			p.Match(LatexParserT__23)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(365)
+		p.GetErrorHandler().Sync(p)
 
-func (s *Block_lineContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterBlock_line(s)
-	}
-}
 
-func (s *Block_lineContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitBlock_line(s)
-	}
-}
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(364)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
 
+			} else if p.HasError() { // JIM
+				goto errorExit
+		}
+		p.SetState(370)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
 
+		for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 4243561906244) != 0) {
+			{
+				p.SetState(367)
+				p.Verbatim_line()
+			}
 
-func (p *LatexParser) Block_line() (localctx IBlock_lineContext) {
-	localctx = NewBlock_lineContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, LatexParserRULE_block_line)
-	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(187)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
+			p.SetState(372)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(373)
+			p.Match(LatexParserT__24)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(375)
+		p.GetErrorHandler().Sync(p)
 
 
-	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 2818176) != 0) {
-		{
-			p.SetState(186)
-			p.Word()
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(374)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+			} else if p.HasError() { // JIM
+				goto errorExit
 		}
 
 
-		p.SetState(189)
+	case LatexParserTABULAR:
+		localctx = NewTabularContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(377)
+			p.Match(LatexParserTABULAR)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
+		}
+		p.SetState(383)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-	    	goto errorExit
-	    }
-		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(192)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 55, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(378)
+					p.Table_row()
+				}
+				{
+					p.SetState(379)
+					p.Match(LatexParserT__1)
+					if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+					}
+				}
 
 
-	for ok := true; ok; ok = _la == LatexParserNEWLINE {
+			}
+			p.SetState(385)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 55, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
 		{
-			p.SetState(191)
-			p.Match(LatexParserNEWLINE)
+			p.SetState(386)
+			p.Table_row()
+		}
+		{
+			p.SetState(387)
+			p.Match(LatexParserT__25)
 			if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 			}
 		}
+		p.SetState(391)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 56, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(388)
+					p.Match(LatexParserWS)
+					if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+					}
+				}
 
 
-		p.SetState(194)
+			}
+			p.SetState(393)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+		    	goto errorExit
+		    }
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 56, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(395)
 		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-	    	goto errorExit
-	    }
-		_la = p.GetTokenStream().LA(1)
-	}
 
 
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(394)
+				p.Match(LatexParserNEWLINE)
+				if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+				}
+			}
+
+			} else if p.HasError() { // JIM
+				goto errorExit
+		}
+
+
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
 
 errorExit:
 	if p.HasError() {
@@ -3747,60 +7776,86 @@ errorExit:
 }
 
 
-// IBlock_itemContext is an interface to support dynamic dispatch.
-type IBlock_itemContext interface {
+// ITable_rowContext is an interface to support dynamic dispatch.
+type ITable_rowContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Block_line() IBlock_lineContext
-	AllWS() []antlr.TerminalNode
-	WS(i int) antlr.TerminalNode
+	AllTable_cell() []ITable_cellContext
+	Table_cell(i int) ITable_cellContext
+	AllAMPERSAND() []antlr.TerminalNode
+	AMPERSAND(i int) antlr.TerminalNode
 
-	// IsBlock_itemContext differentiates from other interfaces.
-	IsBlock_itemContext()
+	// IsTable_rowContext differentiates from other interfaces.
+	IsTable_rowContext()
 }
 
-type Block_itemContext struct {
+type Table_rowContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBlock_itemContext() *Block_itemContext {
-	var p = new(Block_itemContext)
+func NewEmptyTable_rowContext() *Table_rowContext {
+	var p = new(Table_rowContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_block_item
+	p.RuleIndex = LatexParserRULE_table_row
 	return p
 }
 
-func InitEmptyBlock_itemContext(p *Block_itemContext)  {
+func InitEmptyTable_rowContext(p *Table_rowContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_block_item
+	p.RuleIndex = LatexParserRULE_table_row
 }
 
-func (*Block_itemContext) IsBlock_itemContext() {}
+func (*Table_rowContext) IsTable_rowContext() {}
 
-func NewBlock_itemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Block_itemContext {
-	var p = new(Block_itemContext)
+func NewTable_rowContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Table_rowContext {
+	var p = new(Table_rowContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	p.parser = parser
-	p.RuleIndex = LatexParserRULE_block_item
+	p.parser = parser
+	p.RuleIndex = LatexParserRULE_table_row
+
+	return p
+}
+
+func (s *Table_rowContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Table_rowContext) AllTable_cell() []ITable_cellContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITable_cellContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITable_cellContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITable_cellContext); ok {
+			tst[i] = t.(ITable_cellContext)
+			i++
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *Block_itemContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Block_itemContext) Block_line() IBlock_lineContext {
+func (s *Table_rowContext) Table_cell(i int) ITable_cellContext {
 	var t antlr.RuleContext;
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlock_lineContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
+		if _, ok := ctx.(ITable_cellContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
 		}
 	}
 
@@ -3808,90 +7863,80 @@ func (s *Block_itemContext) Block_line() IBlock_lineContext {
 		return nil
 	}
 
-	return t.(IBlock_lineContext)
+	return t.(ITable_cellContext)
 }
 
-func (s *Block_itemContext) AllWS() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserWS)
+func (s *Table_rowContext) AllAMPERSAND() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserAMPERSAND)
 }
 
-func (s *Block_itemContext) WS(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserWS, i)
+func (s *Table_rowContext) AMPERSAND(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserAMPERSAND, i)
 }
 
-func (s *Block_itemContext) GetRuleContext() antlr.RuleContext {
+func (s *Table_rowContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Block_itemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Table_rowContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *Block_itemContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Table_rowContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterBlock_item(s)
+		listenerT.EnterTable_row(s)
 	}
 }
 
-func (s *Block_itemContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Table_rowContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitBlock_item(s)
+		listenerT.ExitTable_row(s)
 	}
 }
 
 
 
 
-func (p *LatexParser) Block_item() (localctx IBlock_itemContext) {
-	localctx = NewBlock_itemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, LatexParserRULE_block_item)
-	var _alt int
+func (p *LatexParser) Table_row() (localctx ITable_rowContext) {
+	localctx = NewTable_rowContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, LatexParserRULE_table_row)
+	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(196)
-		p.Match(LatexParserT__8)
-		if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-		}
+		p.SetState(399)
+		p.Table_cell()
 	}
-	p.SetState(200)
+	p.SetState(404)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(197)
-				p.Match(LatexParserWS)
-				if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-				}
-			}
+	_la = p.GetTokenStream().LA(1)
 
 
+	for _la == LatexParserAMPERSAND {
+		{
+			p.SetState(400)
+			p.Match(LatexParserAMPERSAND)
+			if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+			}
 		}
-		p.SetState(202)
+		{
+			p.SetState(401)
+			p.Table_cell()
+		}
+
+
+		p.SetState(406)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 	    	goto errorExit
 	    }
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(203)
-		p.Block_line()
+		_la = p.GetTokenStream().LA(1)
 	}
 
 
@@ -3910,103 +7955,165 @@ errorExit:
 }
 
 
-// IBlockContext is an interface to support dynamic dispatch.
-type IBlockContext interface {
+// ITable_cellContext is an interface to support dynamic dispatch.
+type ITable_cellContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsBlockContext differentiates from other interfaces.
-	IsBlockContext()
+
+	// Getter signatures
+	AllTag() []ITagContext
+	Tag(i int) ITagContext
+	AllFootnote() []IFootnoteContext
+	Footnote(i int) IFootnoteContext
+	AllCommand() []ICommandContext
+	Command(i int) ICommandContext
+	AllHref() []IHrefContext
+	Href(i int) IHrefContext
+	AllUrl() []IUrlContext
+	Url(i int) IUrlContext
+	AllMath() []IMathContext
+	Math(i int) IMathContext
+	AllWord() []IWordContext
+	Word(i int) IWordContext
+	AllTABLE_RULE() []antlr.TerminalNode
+	TABLE_RULE(i int) antlr.TerminalNode
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+
+	// IsTable_cellContext differentiates from other interfaces.
+	IsTable_cellContext()
 }
 
-type BlockContext struct {
+type Table_cellContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBlockContext() *BlockContext {
-	var p = new(BlockContext)
+func NewEmptyTable_cellContext() *Table_cellContext {
+	var p = new(Table_cellContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_block
+	p.RuleIndex = LatexParserRULE_table_cell
 	return p
 }
 
-func InitEmptyBlockContext(p *BlockContext)  {
+func InitEmptyTable_cellContext(p *Table_cellContext)  {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = LatexParserRULE_block
+	p.RuleIndex = LatexParserRULE_table_cell
 }
 
-func (*BlockContext) IsBlockContext() {}
+func (*Table_cellContext) IsTable_cellContext() {}
 
-func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
-	var p = new(BlockContext)
+func NewTable_cellContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Table_cellContext {
+	var p = new(Table_cellContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = LatexParserRULE_block
+	p.RuleIndex = LatexParserRULE_table_cell
 
 	return p
 }
 
-func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
+func (s *Table_cellContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BlockContext) CopyAll(ctx *BlockContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
-}
+func (s *Table_cellContext) AllTag() []ITagContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITagContext); ok {
+			len++
+		}
+	}
 
-func (s *BlockContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	tst := make([]ITagContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITagContext); ok {
+			tst[i] = t.(ITagContext)
+			i++
+		}
+	}
 
-func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	return tst
 }
 
+func (s *Table_cellContext) Tag(i int) ITagContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
+	if t == nil {
+		return nil
+	}
 
-
-type ItemizeContext struct {
-	BlockContext
+	return t.(ITagContext)
 }
 
-func NewItemizeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ItemizeContext {
-	var p = new(ItemizeContext)
+func (s *Table_cellContext) AllFootnote() []IFootnoteContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFootnoteContext); ok {
+			len++
+		}
+	}
 
-	InitEmptyBlockContext(&p.BlockContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*BlockContext))
+	tst := make([]IFootnoteContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFootnoteContext); ok {
+			tst[i] = t.(IFootnoteContext)
+			i++
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *ItemizeContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *Table_cellContext) Footnote(i int) IFootnoteContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFootnoteContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-func (s *ItemizeContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserNEWLINE)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *ItemizeContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, i)
+	return t.(IFootnoteContext)
 }
 
-func (s *ItemizeContext) AllBlock_item() []IBlock_itemContext {
+func (s *Table_cellContext) AllCommand() []ICommandContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IBlock_itemContext); ok {
+		if _, ok := ctx.(ICommandContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IBlock_itemContext, len)
+	tst := make([]ICommandContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IBlock_itemContext); ok {
-			tst[i] = t.(IBlock_itemContext)
+		if t, ok := ctx.(ICommandContext); ok {
+			tst[i] = t.(ICommandContext)
 			i++
 		}
 	}
@@ -4014,11 +8121,11 @@ func (s *ItemizeContext) AllBlock_item() []IBlock_itemContext {
 	return tst
 }
 
-func (s *ItemizeContext) Block_item(i int) IBlock_itemContext {
+func (s *Table_cellContext) Command(i int) ICommandContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlock_itemContext); ok {
+		if _, ok := ctx.(ICommandContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -4031,63 +8138,105 @@ func (s *ItemizeContext) Block_item(i int) IBlock_itemContext {
 		return nil
 	}
 
-	return t.(IBlock_itemContext)
+	return t.(ICommandContext)
 }
 
+func (s *Table_cellContext) AllHref() []IHrefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IHrefContext); ok {
+			len++
+		}
+	}
 
-func (s *ItemizeContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterItemize(s)
+	tst := make([]IHrefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IHrefContext); ok {
+			tst[i] = t.(IHrefContext)
+			i++
+		}
 	}
+
+	return tst
 }
 
-func (s *ItemizeContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitItemize(s)
+func (s *Table_cellContext) Href(i int) IHrefContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IHrefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
 	}
-}
 
+	if t == nil {
+		return nil
+	}
 
-type EnumerateContext struct {
-	BlockContext
+	return t.(IHrefContext)
 }
 
-func NewEnumerateContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EnumerateContext {
-	var p = new(EnumerateContext)
+func (s *Table_cellContext) AllUrl() []IUrlContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUrlContext); ok {
+			len++
+		}
+	}
 
-	InitEmptyBlockContext(&p.BlockContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*BlockContext))
+	tst := make([]IUrlContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUrlContext); ok {
+			tst[i] = t.(IUrlContext)
+			i++
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *EnumerateContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *Table_cellContext) Url(i int) IUrlContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUrlContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
 
-func (s *EnumerateContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserNEWLINE)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *EnumerateContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, i)
+	return t.(IUrlContext)
 }
 
-func (s *EnumerateContext) AllBlock_item() []IBlock_itemContext {
+func (s *Table_cellContext) AllMath() []IMathContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IBlock_itemContext); ok {
+		if _, ok := ctx.(IMathContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IBlock_itemContext, len)
+	tst := make([]IMathContext, len)
 	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IBlock_itemContext); ok {
-			tst[i] = t.(IBlock_itemContext)
+	for _, ctx := range children {
+		if t, ok := ctx.(IMathContext); ok {
+			tst[i] = t.(IMathContext)
 			i++
 		}
 	}
@@ -4095,11 +8244,11 @@ func (s *EnumerateContext) AllBlock_item() []IBlock_itemContext {
 	return tst
 }
 
-func (s *EnumerateContext) Block_item(i int) IBlock_itemContext {
+func (s *Table_cellContext) Math(i int) IMathContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlock_itemContext); ok {
+		if _, ok := ctx.(IMathContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -4112,63 +8261,23 @@ func (s *EnumerateContext) Block_item(i int) IBlock_itemContext {
 		return nil
 	}
 
-	return t.(IBlock_itemContext)
-}
-
-
-func (s *EnumerateContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterEnumerate(s)
-	}
-}
-
-func (s *EnumerateContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitEnumerate(s)
-	}
-}
-
-
-type VerbatimContext struct {
-	BlockContext
-}
-
-func NewVerbatimContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VerbatimContext {
-	var p = new(VerbatimContext)
-
-	InitEmptyBlockContext(&p.BlockContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*BlockContext))
-
-	return p
-}
-
-func (s *VerbatimContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *VerbatimContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(LatexParserNEWLINE)
-}
-
-func (s *VerbatimContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(LatexParserNEWLINE, i)
+	return t.(IMathContext)
 }
 
-func (s *VerbatimContext) AllVerbatim_line() []IVerbatim_lineContext {
+func (s *Table_cellContext) AllWord() []IWordContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IVerbatim_lineContext); ok {
+		if _, ok := ctx.(IWordContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IVerbatim_lineContext, len)
+	tst := make([]IWordContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IVerbatim_lineContext); ok {
-			tst[i] = t.(IVerbatim_lineContext)
+		if t, ok := ctx.(IWordContext); ok {
+			tst[i] = t.(IWordContext)
 			i++
 		}
 	}
@@ -4176,11 +8285,11 @@ func (s *VerbatimContext) AllVerbatim_line() []IVerbatim_lineContext {
 	return tst
 }
 
-func (s *VerbatimContext) Verbatim_line(i int) IVerbatim_lineContext {
+func (s *Table_cellContext) Word(i int) IWordContext {
 	var t antlr.RuleContext;
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IVerbatim_lineContext); ok {
+		if _, ok := ctx.(IWordContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext);
 				break
@@ -4193,226 +8302,134 @@ func (s *VerbatimContext) Verbatim_line(i int) IVerbatim_lineContext {
 		return nil
 	}
 
-	return t.(IVerbatim_lineContext)
+	return t.(IWordContext)
 }
 
+func (s *Table_cellContext) AllTABLE_RULE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserTABLE_RULE)
+}
 
-func (s *VerbatimContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Table_cellContext) TABLE_RULE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserTABLE_RULE, i)
+}
+
+func (s *Table_cellContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(LatexParserNEWLINE)
+}
+
+func (s *Table_cellContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(LatexParserNEWLINE, i)
+}
+
+func (s *Table_cellContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Table_cellContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *Table_cellContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.EnterVerbatim(s)
+		listenerT.EnterTable_cell(s)
 	}
 }
 
-func (s *VerbatimContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Table_cellContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(LatexListener); ok {
-		listenerT.ExitVerbatim(s)
+		listenerT.ExitTable_cell(s)
 	}
 }
 
 
 
-func (p *LatexParser) Block() (localctx IBlockContext) {
-	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, LatexParserRULE_block)
+
+func (p *LatexParser) Table_cell() (localctx ITable_cellContext) {
+	localctx = NewTable_cellContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, LatexParserRULE_table_cell)
 	var _la int
 
-	p.SetState(253)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(418)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-
-	switch p.GetTokenStream().LA(1) {
-	case LatexParserT__9:
-		localctx = NewItemizeContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(205)
-			p.Match(LatexParserT__9)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-			}
-		}
-		p.SetState(209)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-
-		for _la == LatexParserNEWLINE {
-			{
-				p.SetState(206)
-				p.Match(LatexParserNEWLINE)
-				if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-				}
-			}
+	_la = p.GetTokenStream().LA(1)
 
 
-			p.SetState(211)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-		    	goto errorExit
-		    }
-			_la = p.GetTokenStream().LA(1)
-		}
-		p.SetState(215)
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 3985327009728) != 0) {
+		p.SetState(416)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
 
-
-		for _la == LatexParserT__8 {
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+		case 1:
 			{
-				p.SetState(212)
-				p.Block_item()
+				p.SetState(407)
+				p.Tag()
 			}
 
 
-			p.SetState(217)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-		    	goto errorExit
-		    }
-			_la = p.GetTokenStream().LA(1)
-		}
-		{
-			p.SetState(218)
-			p.Match(LatexParserT__10)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
+		case 2:
+			{
+				p.SetState(408)
+				p.Footnote()
 			}
-		}
-		p.SetState(220)
-		p.GetErrorHandler().Sync(p)
 
 
-		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
+		case 3:
 			{
-				p.SetState(219)
-				p.Match(LatexParserNEWLINE)
-				if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-				}
+				p.SetState(409)
+				p.Command()
 			}
 
-			} else if p.HasError() { // JIM
-				goto errorExit
-		}
-
 
-	case LatexParserT__11:
-		localctx = NewEnumerateContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(222)
-			p.Match(LatexParserT__11)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
+		case 4:
+			{
+				p.SetState(410)
+				p.Href()
 			}
-		}
-		p.SetState(226)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
 
 
-		for _la == LatexParserNEWLINE {
+		case 5:
 			{
-				p.SetState(223)
-				p.Match(LatexParserNEWLINE)
-				if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-				}
+				p.SetState(411)
+				p.Url()
 			}
 
 
-			p.SetState(228)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-		    	goto errorExit
-		    }
-			_la = p.GetTokenStream().LA(1)
-		}
-		p.SetState(232)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-
-		for _la == LatexParserT__8 {
+		case 6:
 			{
-				p.SetState(229)
-				p.Block_item()
+				p.SetState(412)
+				p.Math()
 			}
 
 
-			p.SetState(234)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-		    	goto errorExit
-		    }
-			_la = p.GetTokenStream().LA(1)
-		}
-		{
-			p.SetState(235)
-			p.Match(LatexParserT__12)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
+		case 7:
+			{
+				p.SetState(413)
+				p.Word()
 			}
-		}
-		p.SetState(237)
-		p.GetErrorHandler().Sync(p)
 
 
-		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 35, p.GetParserRuleContext()) == 1 {
+		case 8:
 			{
-				p.SetState(236)
-				p.Match(LatexParserNEWLINE)
+				p.SetState(414)
+				p.Match(LatexParserTABLE_RULE)
 				if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 				}
 			}
 
-			} else if p.HasError() { // JIM
-				goto errorExit
-		}
-
-
-	case LatexParserT__13:
-		localctx = NewVerbatimContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(239)
-			p.Match(LatexParserT__13)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-			}
-		}
-		p.SetState(241)
-		p.GetErrorHandler().Sync(p)
 
-
-		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
+		case 9:
 			{
-				p.SetState(240)
+				p.SetState(415)
 				p.Match(LatexParserNEWLINE)
 				if p.HasError() {
 						// Recognition error - abort rule
@@ -4420,65 +8437,20 @@ func (p *LatexParser) Block() (localctx IBlockContext) {
 				}
 			}
 
-			} else if p.HasError() { // JIM
-				goto errorExit
-		}
-		p.SetState(246)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
+		case antlr.ATNInvalidAltNumber:
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-
-		for ((int64(_la) & ^0x3f) == 0 && ((int64(1) << _la) & 4128900) != 0) {
-			{
-				p.SetState(243)
-				p.Verbatim_line()
-			}
 
-
-			p.SetState(248)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-		    	goto errorExit
-		    }
-			_la = p.GetTokenStream().LA(1)
-		}
-		{
-			p.SetState(249)
-			p.Match(LatexParserT__14)
-			if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-			}
-		}
-		p.SetState(251)
+		p.SetState(420)
 		p.GetErrorHandler().Sync(p)
-
-
-		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 38, p.GetParserRuleContext()) == 1 {
-			{
-				p.SetState(250)
-				p.Match(LatexParserNEWLINE)
-				if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-				}
-			}
-
-			} else if p.HasError() { // JIM
-				goto errorExit
-		}
-
-
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
+		if p.HasError() {
+	    	goto errorExit
+	    }
+		_la = p.GetTokenStream().LA(1)
 	}
 
 
+
 errorExit:
 	if p.HasError() {
 		v := p.GetError()
@@ -1,18 +1,21 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"flag"
 	"log"
 	"os"
+	"path/filepath"
 	"cotonetes/parser"
 	"cotonetes/utils"
 	"regexp"
 )
 
 func main() {
-	db_path_ptr := flag.String("db", "cotonetes.db", "Path to new database file")
+	db_path_ptr := flag.String("db", "cotonetes.db", "Path to database file, created if it does not exist")
 	latex_notes_path_ptr := flag.String("notes", "/tmp/notes", "Path to folder containing notes in latex format")
+	strict_ptr := flag.Bool("strict", false, "Stop at the first note that can not be parsed, without importing any note")
 
 	flag.Parse()
 
@@ -20,44 +23,96 @@ func main() {
 		log.Fatal(fmt.Sprintf("Provided note folder does not exist!: %s", *latex_notes_path_ptr))
 	}
 
-	if  _, error := os.Stat(*db_path_ptr); error == nil {
-		if utils.User_confirmation(fmt.Sprintf("File %s already exists. Delete?", *db_path_ptr)) {
-			if e := os.Remove(*db_path_ptr); e != nil {
-				log.Fatal(e)
-			}
-		} else {
-			fmt.Println("Please re-run the program with another database path to continue")
-			os.Exit(0)
-		}
+	// parse every file before touching the database, so a malformed note does not leave a partial import behind.
+	// Unless strict, malformed notes are skipped and reported once the other notes are imported
+	file_notes, err := parser.Process_files(*latex_notes_path_ptr, "tex", *strict_ptr)
+
+	var skipped parser.ParseErrors
+
+	if err != nil && (*strict_ptr || !errors.As(err, &skipped)) {
+		log.Fatal(err)
 	}
 
-	db_manager := utils.DatabaseManager{*db_path_ptr}
+	db_manager := utils.DatabaseManager{Db_path: *db_path_ptr}
 
-	db := db_manager.OpenDatabase()
+	db, err := db_manager.OpenDatabase()
+	if err != nil {
+		log.Fatal(err)
+	}
 	defer db.Close()
 
-	tx := db_manager.BeginTransaction(db)
+	tx, err := db_manager.BeginTransaction(db)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	db_manager.CreateDatabase(tx)
+	// the import is all or nothing, any database error rolls back every change made so far
+	abort := func(err error) {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Fatalf("%v: unable to rollback: %v\n", err, rollbackErr)
+		}
+		log.Fatal(err)
+	}
 
-	file_notes := parser.Process_files(*latex_notes_path_ptr, "tex")
+	if err = db_manager.CreateDatabase(tx); err != nil {
+		abort(err)
+	}
 
 	category_re := regexp.MustCompile(regexp.QuoteMeta(*latex_notes_path_ptr) + string(os.PathSeparator) + `(.*)` + string(os.PathSeparator) + `.*$`)
 
+	added, updated, unchanged, linked := 0, 0, 0, 0
+
+	// ids of the categories already created, by path
+	category_ids := make(map[string]int64)
+
 	for _, f := range file_notes {
 
 		file_path := f.File_path
 
-		category := category_re.ReplaceAllString(file_path, "$1")
+		// the section headings of the file give the category of its notes, falling back to the folder holding it
+		folder_category := filepath.ToSlash(category_re.ReplaceAllString(file_path, "$1"))
 
-		cat_id := db_manager.CreateCategory(tx, category)
+		for index, note := range f.Notes {
+			category := f.NoteCategory(index, folder_category)
 
-		for _, note := range f.Notes {
-			note_id := db_manager.AddNote(tx, note)
+			cat_id, found := category_ids[category]
+			if !found {
+				if cat_id, err = db_manager.CreateCategory(tx, category); err != nil {
+					abort(err)
+				}
 
-			db_manager.AddNoteCategory(tx, note_id, cat_id)
+				category_ids[category] = cat_id
+			}
+
+			result, err := db_manager.ImportNote(tx, note, cat_id)
+			if err != nil {
+				abort(fmt.Errorf("%s: %w", file_path, err))
+			}
+
+			switch result {
+			case utils.NoteAdded:
+				added++
+			case utils.NoteUpdated:
+				updated++
+			case utils.NoteUnchanged:
+				unchanged++
+			case utils.NoteLinked:
+				linked++
+			}
 		}
 	}
 
-	db_manager.CommitTransaction(tx)
+	if err = db_manager.CommitTransaction(tx); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Notes added: %d, updated: %d, unchanged: %d, linked to another category: %d\n", added, updated, unchanged, linked)
+
+	if len(skipped) > 0 {
+		fmt.Printf("\n%d errors found, the notes with errors were skipped:\n", len(skipped))
+
+		for _, parse_err := range skipped {
+			fmt.Println("  " + parse_err.Error())
+		}
+	}
 }
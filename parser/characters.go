@@ -0,0 +1,391 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TextEncoding is how the exported latex writes accented letters and typographic characters
+type TextEncoding int
+
+const (
+	// the characters are written as they are, for documents using the inputenc and fontenc packages
+	UnicodeText TextEncoding = iota
+	// the characters are written as latex commands and ligatures, e.g. \'e and --, which only need ascii
+	AsciiText
+)
+
+// latex accents, given by a symbol, e.g. \'e, or by a letter, e.g. \c{c}, along with the letters each of them can
+// be put on, each one followed by the accented letter
+var latex_accents = map[string]string{
+	"'":  "AÁaáCĆcćEÉeéGǴgǵIÍiíKḰkḱLĹlĺMḾmḿNŃnńOÓoóPṔpṕRŔrŕSŚsśUÚuúWẂwẃYÝyýZŹzź",
+	"`":  "AÀaàEÈeèIÌiìNǸnǹOÒoòUÙuùWẀwẁYỲyỳ",
+	"^":  "AÂaâCĈcĉEÊeêGĜgĝHĤhĥIÎiîJĴjĵOÔoôSŜsŝUÛuûWŴwŵYŶyŷZẐzẑ",
+	"\"": "AÄaäEËeëHḦhḧIÏiïOÖoötẗUÜuüWẄwẅXẌxẍYŸyÿ",
+	"~":  "AÃaãEẼeẽIĨiĩNÑnñOÕoõUŨuũVṼvṽYỸyỹ",
+	"=":  "AĀaāEĒeēGḠgḡIĪiīOŌoōUŪuūYȲyȳ",
+	".":  "AȦaȧBḂbḃCĊcċDḊdḋEĖeėFḞfḟGĠgġHḢhḣIİMṀmṁNṄnṅOȮoȯPṖpṗRṘrṙSṠsṡTṪtṫWẆwẇXẊxẋYẎyẏZŻzż",
+	"c":  "CÇcçDḐdḑEȨeȩGĢgģHḨhḩKĶkķLĻlļNŅnņRŖrŗSŞsşTŢtţ",
+	"u":  "AĂaăEĔeĕGĞgğIĬiĭOŎoŏUŬuŭ",
+	"v":  "AǍaǎCČcčDĎdďEĚeěGǦgǧHȞhȟIǏiǐjǰKǨkǩLĽlľNŇnňOǑoǒRŘrřSŠsšTŤtťUǓuǔZŽzž",
+	"H":  "OŐoőUŰuű",
+	"k":  "AĄaąEĘeęIĮiįOǪoǫUŲuų",
+	"r":  "AÅaåUŮuůwẘyẙ",
+	"d":  "AẠaạBḄbḅDḌdḍEẸeẹHḤhḥIỊiịKḲkḳLḶlḷMṂmṃNṆnṇOỌoọRṚrṛSṢsṣTṬtṭUỤuụVṾvṿWẈwẉYỴyỵZẒzẓ",
+	"b":  "BḆbḇDḎdḏhẖKḴkḵLḺlḻNṈnṉRṞrṟTṮtṯZẔzẕ",
+}
+
+// latex commands written for letters and typographic characters, along with the unicode they are read as
+var latex_symbols = map[string]string{
+	"ss":                "ß",
+	"o":                 "ø",
+	"O":                 "Ø",
+	"ae":                "æ",
+	"AE":                "Æ",
+	"oe":                "œ",
+	"OE":                "Œ",
+	"aa":                "å",
+	"AA":                "Å",
+	"l":                 "ł",
+	"L":                 "Ł",
+	"i":                 "ı",
+	"j":                 "ȷ",
+	"ldots":             "…",
+	"dots":              "…",
+	"textellipsis":      "…",
+	"textendash":        "–",
+	"textemdash":        "—",
+	"textquotedblleft":  "“",
+	"textquotedblright": "”",
+	"textquoteleft":     "‘",
+	"textquoteright":    "’",
+}
+
+// latex typographic sequences, along with the unicode they are read as. --- goes before --, and `` before `
+var latex_typography = []struct {
+	Latex   string
+	Unicode string
+}{
+	{"---", "—"},
+	{"--", "–"},
+	{"``", "“"},
+	{"''", "”"},
+	{"`", "‘"},
+	{"~", "\u00a0"},
+}
+
+// latex written for the unicode characters that are not accented letters when exporting as ascii. A right single
+// quote is written as an apostrophe, which is read back as such
+var unicode_latex = map[rune]string{
+	'ß':      `\ss{}`,
+	'ø':      `\o{}`,
+	'Ø':      `\O{}`,
+	'æ':      `\ae{}`,
+	'Æ':      `\AE{}`,
+	'œ':      `\oe{}`,
+	'Œ':      `\OE{}`,
+	'å':      `\aa{}`,
+	'Å':      `\AA{}`,
+	'ł':      `\l{}`,
+	'Ł':      `\L{}`,
+	'ı':      `\i{}`,
+	'ȷ':      `\j{}`,
+	'…':      `\ldots{}`,
+	'—':      "---",
+	'–':      "--",
+	'“':      "``",
+	'”':      "''",
+	'‘':      "`",
+	'’':      "'",
+	'\u00a0': "~",
+}
+
+// unicode_accents maps each accented letter to the latex accent and the letter it is put on
+var unicode_accents = func() map[rune][2]string {
+	accents := make(map[rune][2]string)
+
+	for accent, letters := range latex_accents {
+		runes := []rune(letters)
+
+		for index := 0; index+1 < len(runes); index += 2 {
+			accents[runes[index+1]] = [2]string{accent, string(runes[index])}
+		}
+	}
+
+	return accents
+}()
+
+func is_ascii_letter(char byte) bool {
+	return char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z'
+}
+
+// accented_letter returns the letter with the given latex accent. The boolean return value is false if there is no
+// such letter in unicode
+func accented_letter(accent string, letter string) (string, bool) {
+	runes := []rune(latex_accents[accent])
+
+	for index := 0; index+1 < len(runes); index += 2 {
+		if string(runes[index]) == letter {
+			return string(runes[index+1]), true
+		}
+	}
+
+	return "", false
+}
+
+// accent_argument returns the letter an accent is put on, given at index either in braces or as the letter itself,
+// and the index following it. The dotless \i and \j are read as i and j, and empty braces as no letter, e.g. for a
+// caret written as \^{}. Accents given by a letter, e.g. \c c, need a space or braces before their argument
+func accent_argument(line string, index int, is_letter_accent bool) (string, int, bool) {
+	if argument, next_index, found := command_argument(line, index); found {
+		if argument == "" || argument == `\i` || argument == `\j` || len(argument) == 1 && is_ascii_letter(argument[0]) {
+			return strings.TrimPrefix(argument, `\`), next_index, true
+		}
+
+		return "", index, false
+	}
+
+	if is_letter_accent {
+		if index >= len(line) || line[index] != ' ' {
+			return "", index, false
+		}
+
+		index = len(line) - len(strings.TrimLeft(line[index:], " "))
+	}
+
+	if strings.HasPrefix(line[index:], `\i`) || strings.HasPrefix(line[index:], `\j`) {
+		if index+2 == len(line) || !is_ascii_letter(line[index+2]) {
+			return line[index+1 : index+2], index + 2, true
+		}
+	}
+
+	if index < len(line) && is_ascii_letter(line[index]) {
+		return line[index : index+1], index + 1, true
+	}
+
+	return "", index, false
+}
+
+// latex_character returns the unicode characters of the accent command, letter command or typographic sequence
+// starting at index, e.g. é for \'e, and the index following it. Characters outside of ascii that are not letters,
+// e.g. already written as “, are returned as they are. The boolean return value is false if there are none at index
+func latex_character(line string, index int) (string, int, bool) {
+	for _, sequence := range latex_typography {
+		if strings.HasPrefix(line[index:], sequence.Latex) {
+			return sequence.Unicode, index + len(sequence.Latex), true
+		}
+	}
+
+	if line[index] != '\\' {
+		if char, size := utf8.DecodeRuneInString(line[index:]); size > 1 && !unicode.IsLetter(char) {
+			return line[index : index+size], index + size, true
+		}
+
+		return "", index, false
+	}
+
+	if index+1 == len(line) {
+		return "", index, false
+	}
+
+	// accents given by a symbol, e.g. \'e
+	if accent := line[index+1 : index+2]; !is_ascii_letter(accent[0]) && latex_accents[accent] != "" {
+		letter, next_index, found := accent_argument(line, index+2, false)
+
+		switch {
+		case !found:
+			return "", index, false
+		case letter == "":
+			// the accent alone, e.g. \^{}
+			return accent, next_index, true
+		}
+
+		if accented, found := accented_letter(accent, letter); found {
+			return accented, next_index, true
+		}
+
+		return "", index, false
+	}
+
+	name_end := index + 1
+	for name_end < len(line) && is_ascii_letter(line[name_end]) {
+		name_end++
+	}
+
+	name := line[index+1 : name_end]
+
+	// accents given by a letter, e.g. \c{c}
+	if latex_accents[name] != "" {
+		if letter, next_index, found := accent_argument(line, name_end, true); found && letter != "" {
+			if accented, found := accented_letter(name, letter); found {
+				return accented, next_index, true
+			}
+		}
+
+		return "", index, false
+	}
+
+	if symbol, found := latex_symbols[name]; found {
+		// as in latex, the empty braces or the space ending the command name are left out
+		switch {
+		case strings.HasPrefix(line[name_end:], "{}"):
+			name_end += 2
+		case strings.HasPrefix(line[name_end:], " "):
+			name_end++
+		}
+
+		return symbol, name_end, true
+	}
+
+	return "", index, false
+}
+
+// latex_to_unicode replaces the accent commands, letter commands and typographic sequences of the line by their
+// unicode characters. Unless is_placeholder, the characters are written as they are, otherwise those that are not
+// letters to the grammar are written as placeholders. Other commands and escaped characters are kept as they are
+func latex_to_unicode(line string, is_placeholder bool) string {
+	var replaced strings.Builder
+
+	for index := 0; index < len(line); {
+		if text, next_index, found := latex_character(line, index); found {
+			if is_placeholder {
+				text = character_placeholders(text)
+			}
+
+			replaced.WriteString(text)
+			index = next_index
+			continue
+		}
+
+		next_index := index + 1
+		if line[index] == '\\' {
+			next_index = min(index+2, len(line))
+		}
+
+		replaced.WriteString(line[index:next_index])
+		index = next_index
+	}
+
+	return replaced.String()
+}
+
+// placeholder of a character that is not a letter to the grammar, holding its code point
+var character_placeholder_re = regexp.MustCompile(`ǂt(\d+)ǂ`)
+
+// character_placeholders replaces the characters of the text that are not letters by placeholders
+func character_placeholders(text string) string {
+	var replaced strings.Builder
+
+	for _, char := range text {
+		if unicode.IsLetter(char) {
+			replaced.WriteRune(char)
+		} else {
+			replaced.WriteString(fmt.Sprintf("ǂt%dǂ", char))
+		}
+	}
+
+	return replaced.String()
+}
+
+// restore_characters replaces the character placeholders of a line by their characters
+func restore_characters(line string) string {
+	return character_placeholder_re.ReplaceAllStringFunc(line, func(placeholder string) string {
+		char, _ := strconv.Atoi(character_placeholder_re.FindStringSubmatch(placeholder)[1])
+
+		return string(rune(char))
+	})
+}
+
+// unicode_to_latex writes the accented letters and typographic characters of a latex line as latex commands and
+// ligatures. Math, inline code, urls and image paths are kept as they are. Characters without such a command are
+// kept as well
+func unicode_to_latex(line string) string {
+	var latex strings.Builder
+
+	// verbatim_end returns the index following the math, inline code or command argument starting at index, which
+	// are kept as they are, or index if there is none
+	verbatim_end := func(index int) int {
+		if next_index := math_end(line, index); next_index > index {
+			return next_index
+		}
+
+		if _, next_index, found := inline_code(line, index); found {
+			return next_index
+		}
+
+		if image := includegraphics_re.FindStringIndex(line[index:]); image != nil && image[0] == 0 {
+			return index + image[1]
+		}
+
+		// the url of \href is kept, its text is not
+		for _, command := range []string{`\url`, `\href`} {
+			if strings.HasPrefix(line[index:], command+"{") {
+				if _, next_index, found := command_argument(line, index+len(command)); found {
+					return next_index
+				}
+			}
+		}
+
+		return index
+	}
+
+	for index := 0; index < len(line); {
+		if next_index := verbatim_end(index); next_index > index {
+			latex.WriteString(line[index:next_index])
+			index = next_index
+			continue
+		}
+
+		if line[index] == '\\' {
+			next_index := min(index+2, len(line))
+
+			latex.WriteString(line[index:next_index])
+			index = next_index
+			continue
+		}
+
+		char, size := utf8.DecodeRuneInString(line[index:])
+
+		if command, found := unicode_latex[char]; found {
+			latex.WriteString(command)
+		} else if accent, found := unicode_accents[char]; found && is_ascii_letter(accent[0][0]) {
+			latex.WriteString(`\` + accent[0] + `{` + accent[1] + `}`)
+		} else if found {
+			latex.WriteString(`\` + accent[0] + accent[1])
+		} else {
+			latex.WriteString(line[index : index+size])
+		}
+
+		index += size
+	}
+
+	return latex.String()
+}
+
+// latex_note_to_ascii writes the accented letters and typographic characters of the lines of an exported note as
+// latex commands and ligatures, leaving code blocks and display math as they are
+func latex_note_to_ascii(note []string) []string {
+	is_verbatim := false
+	is_math := false
+
+	for index, line := range note {
+		switch {
+		case is_verbatim:
+			is_verbatim = !code_block_end_re.MatchString(line)
+		case code_block_begin_re.MatchString(line):
+			is_verbatim = !code_block_end_re.MatchString(line)
+		case is_math:
+			is_math = strings.TrimSpace(line) != `\]`
+		case strings.TrimSpace(line) == `\[`:
+			is_math = true
+		default:
+			note[index] = unicode_to_latex(line)
+		}
+	}
+
+	return note
+}
@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"cotonetes/types"
+	"cotonetes/utils"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNoteAccentVariants(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`\'{e} \c c \'\i \^{} \~{} \o{} and \textendash{} “raw” \u{g} \H{o} \ae{}r`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Text = []string{"é ç í ^ ~ ø and – “raw” ğ ő ær"}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestAsciiExport(t *testing.T) {
+	file_path := t.TempDir() + "/test.tex"
+
+	err := Export_to_latex_file(file_path, "ação", []types.Note{utils.TdNoteAccents.Markdown}, utils.TdDateLayout, AsciiText, AutoCode)
+
+	utils.FailNotEquals(t, "Failed export", nil, err)
+
+	latex, err := os.ReadFile(file_path)
+
+	utils.FailNotEquals(t, "Failed to read the file", nil, err)
+
+	expected_content := append([]string{`\section{a\c{c}\~ao}`, ""}, utils.NoteToLatex(utils.TdNoteAccents.Latex)...)
+
+	utils.FailNotEqualsSlice(t, "Failed to export note content line", expected_content, strings.Split(strings.TrimSuffix(string(latex), "\n"), "\n"))
+}
@@ -0,0 +1,316 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// code block environments, whose lines are read as they are
+var code_block_begin_re = regexp.MustCompile(`\\begin\{(verbatim|lstlisting|minted)\}`)
+
+var code_block_end_re = regexp.MustCompile(`\\end\{(verbatim|lstlisting|minted)\}`)
+
+// a code block starting a line, followed by its options and language
+var code_block_re = regexp.MustCompile(`^\s*\\begin\{(lstlisting|minted)\}(.*)$`)
+
+var code_fence_re = regexp.MustCompile("^```\\s*([\\w+#.-]*)\\s*$")
+
+// is_code_fence returns whether the markdown line opens a code block, or closes it if is_code. A code block opens with
+// a fence, which may hold its language, and closes with a bare fence
+func is_code_fence(line string, is_code bool) bool {
+	fence := code_fence_re.FindStringSubmatch(line)
+
+	return fence != nil && (!is_code || fence[1] == "")
+}
+
+// markdown code fence languages known to the listings package, along with the listings language they are exported as.
+// The first fence language of each listings language is the one it is imported as. Other languages are exported as
+// minted environments, which know most languages
+var listings_languages = []struct {
+	Fence    string
+	Listings string
+}{
+	{"bash", "bash"},
+	{"shell", "bash"},
+	{"sh", "sh"},
+	{"c", "C"},
+	{"cpp", "C++"},
+	{"c++", "C++"},
+	{"java", "Java"},
+	{"python", "Python"},
+	{"py", "Python"},
+	{"ruby", "Ruby"},
+	{"perl", "Perl"},
+	{"php", "PHP"},
+	{"sql", "SQL"},
+	{"html", "HTML"},
+	{"xml", "XML"},
+	{"haskell", "Haskell"},
+	{"make", "make"},
+	{"makefile", "make"},
+	{"r", "R"},
+	{"matlab", "Matlab"},
+	{"tex", "TeX"},
+	{"latex", "TeX"},
+	{"erlang", "erlang"},
+	{"lisp", "Lisp"},
+	{"fortran", "Fortran"},
+	{"pascal", "Pascal"},
+}
+
+var listings_dialect_re = regexp.MustCompile(`\[[^]]*\]`)
+
+// code_block_language returns the fence language of an lstlisting or minted block beginning, given by the language
+// option of lstlisting, or the argument of minted. The boolean return value is false if the line does not begin such
+// a block
+func code_block_language(line string) (string, bool) {
+	match := code_block_re.FindStringSubmatch(line)
+
+	if match == nil {
+		return "", false
+	}
+
+	options, rest := "", strings.TrimSpace(match[2])
+
+	// the options may hold brackets within braces, e.g. language={[Sharp]C}
+	if strings.HasPrefix(rest, "[") {
+		parts := split_latex(rest[1:], "]")
+		if len(parts) < 2 {
+			return "", false
+		}
+
+		options, rest = parts[0], strings.TrimSpace(strings.Join(parts[1:], "]"))
+	}
+
+	if match[1] == "minted" {
+		language, next_index, found := command_argument(rest, 0)
+		if !found || strings.TrimSpace(rest[next_index:]) != "" {
+			return "", false
+		}
+
+		return strings.TrimSpace(language), true
+	}
+
+	if rest != "" {
+		return "", false
+	}
+
+	for _, option := range split_latex(options, ",") {
+		key, value, _ := strings.Cut(option, "=")
+
+		if strings.TrimSpace(key) != "language" {
+			continue
+		}
+
+		// a dialect, e.g. [Sharp]C, is left out
+		value = listings_dialect_re.ReplaceAllString(strings.Trim(strings.TrimSpace(value), "{}"), "")
+
+		for _, language := range listings_languages {
+			if strings.EqualFold(language.Listings, value) {
+				return language.Fence, true
+			}
+		}
+
+		return strings.ToLower(value), true
+	}
+
+	return "", true
+}
+
+// CodeEnvironment is the latex environment the exported code blocks with a language are written as
+type CodeEnvironment int
+
+const (
+	// lstlisting for the languages known to the listings package, minted for any other language
+	AutoCode CodeEnvironment = iota
+	// lstlisting for every language, those unknown to the listings package needing \lstdefinelanguage in the document
+	LstlistingCode
+	// minted for every language
+	MintedCode
+)
+
+// Parse_code_environment reads the name of a code environment: lstlisting, minted or auto
+func Parse_code_environment(name string) (CodeEnvironment, error) {
+	switch name {
+	case "auto":
+		return AutoCode, nil
+	case "lstlisting":
+		return LstlistingCode, nil
+	case "minted":
+		return MintedCode, nil
+	}
+
+	return AutoCode, fmt.Errorf("unknown code environment %q, expected lstlisting, minted or auto", name)
+}
+
+// markdown_code_block returns the beginning and end of the latex environment a code fence with the given language
+// is exported as, given the environment chosen for code blocks with a language
+func markdown_code_block(language string, environment CodeEnvironment) (string, string) {
+	if language == "" {
+		return `\begin{verbatim}`, `\end{verbatim}`
+	}
+
+	if environment != MintedCode {
+		for _, listings := range listings_languages {
+			if strings.EqualFold(listings.Fence, language) {
+				return `\begin{lstlisting}[language=` + listings.Listings + `]`, `\end{lstlisting}`
+			}
+		}
+	}
+
+	if environment == LstlistingCode {
+		return `\begin{lstlisting}[language=` + language + `]`, `\end{lstlisting}`
+	}
+
+	return `\begin{minted}{` + language + `}`, `\end{minted}`
+}
+
+var code_placeholder_re = regexp.MustCompile(`ǂc(\d+)ǂ`)
+
+// placeholder of code within a table cell, whose pipes are escaped as it is restored
+var table_code_placeholder_re = regexp.MustCompile(`ǂp(\d+)ǂ`)
+
+// inline_code returns the code of the \verb or \lstinline command starting at index, and the index following it.
+// The code is delimited by any character, or by braces for \lstinline. The boolean return value is false if there is
+// no such command at index, or if it is not closed on the line
+func inline_code(line string, index int) (string, int, bool) {
+	var code_index int
+
+	switch {
+	case strings.HasPrefix(line[index:], `\verb*`):
+		code_index = index + len(`\verb*`)
+	case strings.HasPrefix(line[index:], `\verb`):
+		code_index = index + len(`\verb`)
+	case strings.HasPrefix(line[index:], `\lstinline`):
+		code_index = index + len(`\lstinline`)
+
+		if strings.HasPrefix(line[code_index:], "[") {
+			close_index := strings.IndexByte(line[code_index:], ']')
+			if close_index < 0 {
+				return "", index, false
+			}
+
+			code_index += close_index + 1
+		}
+
+		if strings.HasPrefix(line[code_index:], "{") {
+			return command_argument(line, code_index)
+		}
+	default:
+		return "", index, false
+	}
+
+	// the delimiter can not be a letter, which would be read as part of the command name
+	if code_index >= len(line) || line[code_index] == ' ' || line[code_index] >= 'a' && line[code_index] <= 'z' || line[code_index] >= 'A' && line[code_index] <= 'Z' {
+		return "", index, false
+	}
+
+	close_index := strings.IndexByte(line[code_index+1:], line[code_index])
+	if close_index < 0 {
+		return "", index, false
+	}
+
+	return line[code_index+1 : code_index+1+close_index], code_index + close_index + 2, true
+}
+
+// add_code keeps the markdown of some inline code, returning its placeholder, as code may hold characters the
+// grammar does not accept
+func (f *inline_formatter) add_code(code string) string {
+	f.code = append(f.code, "`"+code+"`")
+
+	return fmt.Sprintf("ǂc%dǂ", len(f.code)-1)
+}
+
+var code_line_placeholder_re = regexp.MustCompile(`^ǂv(\d+)ǂ$`)
+
+// add_code_line keeps a line of a code block, returning its placeholder
+func (f *inline_formatter) add_code_line(line string) string {
+	f.code_lines = append(f.code_lines, line)
+
+	return fmt.Sprintf("ǂv%dǂ", len(f.code_lines)-1)
+}
+
+// markdown_code_to_placeholders replaces the inline code of a markdown line by placeholders, so it is kept out of the
+// formatting and escaping of the rest of the line. It returns the replaced line along with the code of each placeholder
+func markdown_code_to_placeholders(line string) (string, []string) {
+	var replaced strings.Builder
+
+	code := make([]string, 0)
+
+	for index := 0; index < len(line); {
+		if line[index] == '\\' && index+1 < len(line) {
+			replaced.WriteString(line[index : index+2])
+			index += 2
+			continue
+		}
+
+		if line[index] == '`' {
+			if code_end := strings.IndexByte(line[index+1:], '`'); code_end >= 0 {
+				replaced.WriteString(fmt.Sprintf("ǂc%dǂ", len(code)))
+				code = append(code, line[index+1:index+1+code_end])
+				index += code_end + 2
+				continue
+			}
+		}
+
+		replaced.WriteByte(line[index])
+		index++
+	}
+
+	return replaced.String(), code
+}
+
+// verb_delimiters are the characters \verb code may be delimited with, the first one not in the code being used
+const verb_delimiters = "|!+@=/"
+
+// code_to_latex replaces the inline code placeholders of a latex line by \verb commands. As \verb can not be used in
+// the argument of another command, code within braces, or anywhere if is_argument, is written as \texttt instead, as
+// is code holding every delimiter
+func code_to_latex(line string, code []string, is_argument bool) string {
+	var latex strings.Builder
+
+	depth := 0
+	if is_argument {
+		depth = 1
+	}
+
+	position := 0
+
+	for _, match := range code_placeholder_re.FindAllStringSubmatchIndex(line, -1) {
+		// the braces before the code, escaped braces left out, give its depth
+		for index := position; index < match[0]; index++ {
+			switch line[index] {
+			case '\\':
+				index++
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+		}
+
+		latex.WriteString(line[position:match[0]])
+		position = match[1]
+
+		number, _ := strconv.Atoi(line[match[2]:match[3]])
+
+		if number >= len(code) {
+			latex.WriteString(line[match[0]:match[1]])
+			continue
+		}
+
+		delimiter := strings.IndexFunc(verb_delimiters, func(r rune) bool { return !strings.ContainsRune(code[number], r) })
+
+		if depth == 0 && delimiter >= 0 {
+			latex.WriteString(`\verb` + verb_delimiters[delimiter:delimiter+1] + code[number] + verb_delimiters[delimiter:delimiter+1])
+		} else {
+			latex.WriteString(`\texttt{` + escape_special_chars(code[number]) + `}`)
+		}
+	}
+
+	latex.WriteString(line[position:])
+
+	return latex.String()
+}
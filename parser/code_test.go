@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"cotonetes/utils"
+	"testing"
+)
+
+func TestNoteCodeVariants(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`\begin{lstlisting}[caption={A query}, language={[ANSI]SQL}]`,
+		`select 1; -- 100% sure`,
+		`\end{lstlisting}`,
+		`\begin{lstlisting}[language=Go]`,
+		`x := 1`,
+		`\end{lstlisting}`,
+		`\begin{minted}[linenos]{rust}`,
+		`let x = 1;`,
+		`\end{minted}`,
+		`\begin{lstlisting}`,
+		`no language`,
+		`\end{lstlisting}`,
+		`\lstinline{a_b} and \lstinline[language=C]|*p| and \verb*+c d+, \texttt{e\_f} % comment`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Text = []string{
+		"```sql", `select 1; -- 100% sure`, "```",
+		"```go", `x := 1`, "```",
+		"```rust", `let x = 1;`, "```",
+		"```", `no language`, "```",
+		"`a_b` and `*p` and `c d`, `e_f`",
+	}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestMarkdownCodeBlocks(t *testing.T) {
+	latex := markdown_note_to_latex([]string{"```sh", "ls", "```", "```rust", "```go", "```", "`a|b!c+d@e=f/g`, [`link`](https://example.com)"}, AutoCode)
+
+	utils.FailNotEqualsSlice(t, "Failed to export code blocks", []string{
+		`\begin{lstlisting}[language=sh]`, `ls`, `\end{lstlisting}`,
+		`\begin{minted}{rust}`, "```go", `\end{minted}`,
+		`\texttt{a|b!c+d@e=f/g}, \href{https://example.com}{\texttt{link}}`,
+	}, latex)
+}
+
+func TestMarkdownCodeEnvironment(t *testing.T) {
+	markdown := []string{"```python", "pass", "```", "```rust", "```", "```", "```"}
+
+	utils.FailNotEqualsSlice(t, "Failed to export code blocks as lstlisting", []string{
+		`\begin{lstlisting}[language=Python]`, `pass`, `\end{lstlisting}`,
+		`\begin{lstlisting}[language=rust]`, `\end{lstlisting}`,
+		`\begin{verbatim}`, `\end{verbatim}`,
+	}, markdown_note_to_latex(markdown, LstlistingCode))
+
+	utils.FailNotEqualsSlice(t, "Failed to export code blocks as minted", []string{
+		`\begin{minted}{python}`, `pass`, `\end{minted}`,
+		`\begin{minted}{rust}`, `\end{minted}`,
+		`\begin{verbatim}`, `\end{verbatim}`,
+	}, markdown_note_to_latex(markdown, MintedCode))
+
+	if _, err := Parse_code_environment("listings"); err == nil {
+		t.Fatal("Failed to reject unknown code environment")
+	}
+}
@@ -3,10 +3,13 @@ package parser
 import (
 	"fmt"
 	"os"
-	"log"
 	"bufio"
+	"errors"
+	"path/filepath"
 	"cotonetes/types"
+	"cotonetes/utils"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -16,187 +19,626 @@ func escape_special_chars(line string) string {
 
 	line = special_re.ReplaceAllString(line, `\$1`)
 	line = strings.ReplaceAll(line, `$`, `\$`)
-	line = strings.ReplaceAll(line, `^`, `\^`)
+
+	// ^ and ~ are accents, written with empty braces so they are not put on the following letter
+	line = strings.ReplaceAll(line, `^`, `\^{}`)
+	line = strings.ReplaceAll(line, `~`, `\~{}`)
 
 	return line
 }
 
-func markdown_note_to_latex(markdown_note []string) []string {
+var metadata_url_value_re = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://\S+$`)
+
+// metadata_value_to_latex returns the latex of a metadata value, written as a \url if it is one, or else converted as a
+// line of note text is
+func metadata_value_to_latex(value string) string {
+	if metadata_url_value_re.MatchString(value) {
+		return `\url{` + value + `}`
+	}
+
+	return markdown_line_to_latex(value)
+}
+
+// escape_href_url escapes the characters that hyperref does not accept as written in the url of a \href
+func escape_href_url(url string) string {
+	return strings.NewReplacer(`#`, `\#`, `%`, `\%`).Replace(url)
+}
+
+// markdown inline formatting markers, each with the closing marker and the latex command it is written as
+var markdown_inline_formats = []struct {
+	Open    string
+	Close   string
+	Command string
+}{
+	// bold goes before emphasis, so "**" is not read as two emphasis markers
+	{"**", "**", "textbf"},
+	{"*", "*", "emph"},
+	{"<u>", "</u>", "underline"},
+}
+
+// markdown_inline_to_latex replaces the markdown inline formatting of the line, which may be nested, by latex commands.
+// Markers that are not closed, and emphasis markers followed by a space, e.g. "2 * 3", are kept as they are.
+// Emphasis markers followed by another one are read as the closing of bold text, e.g. "*a **b***"
+func markdown_inline_to_latex(line string) string {
+	latex, _, _ := markdown_inline_until(line, "")
+
+	return latex
+}
+
+// markdown_inline_until converts the line up to the given closing marker, returning the converted text, the length
+// of the line consumed, closing marker included, and whether the closing marker was found
+func markdown_inline_until(line string, close_marker string) (string, int, bool) {
+	var latex strings.Builder
+
+	index := 0
+
+	next_format:
+	for index < len(line) {
+		switch {
+		case line[index] == '\\' && index+1 < len(line):
+			// escaped markdown characters, e.g. \*, are kept for the escaping done later on
+			latex.WriteString(line[index : index+2])
+			index += 2
+			continue
+		}
+
+		// other formats are tried before the closing marker, so e.g. bold can be nested in emphasis
+		for _, format := range markdown_inline_formats {
+			if format.Open == close_marker || !strings.HasPrefix(line[index:], format.Open) {
+				continue
+			}
+
+			content_index := index + len(format.Open)
+
+			if format.Open == "*" && (content_index == len(line) || line[content_index] == ' ' || line[content_index] == '*') {
+				break
+			}
+
+			if content, length, closed := markdown_inline_until(line[content_index:], format.Close); closed {
+				latex.WriteString(`\` + format.Command + `{` + content + `}`)
+				index = content_index + length
+				continue next_format
+			}
+
+			break
+		}
+
+		if close_marker != "" && strings.HasPrefix(line[index:], close_marker) {
+			return latex.String(), index + len(close_marker), true
+		}
+
+		latex.WriteByte(line[index])
+		index++
+	}
+
+	return latex.String(), index, false
+}
+
+func markdown_note_to_latex(markdown_note []string, code_environment CodeEnvironment) []string {
+	// footnotes are written where they are referenced, so their definitions are left out of the text
+	text, footnotes := markdown_footnotes_to_placeholders(markdown_note)
+
+	note := markdown_text_to_latex(text, false, code_environment)
+
+	for index := range note {
+		note[index] = footnotes_to_latex(note[index], footnotes)
+	}
+
+	return note
+}
+
+// markdown_text_to_latex converts the lines of a note text, or those of a quote within it if is_quote. Code blocks with
+// a language are written in the given code_environment
+func markdown_text_to_latex(markdown_note []string, is_quote bool, code_environment CodeEnvironment) []string {
 	note := make([]string, 0)
 
-	bold_re := regexp.MustCompile(`\*\*([^*]*)\*\*`)
-	url_re := regexp.MustCompile(`\.*\[([^]]*)\]\(([^)]*)\)\.*`)
-	newline_re := regexp.MustCompile(`^ *$`)
-	item_re := regexp.MustCompile(`^ *\* +(.*)$`)
-	num_re := regexp.MustCompile(`^ *[1-9]+\. +(.*)$`)
-	is_itemize := false
-	is_enumerate := false
+	var lists []markdown_list
 	is_verbatim := false
-
-	// Some notes have sections written as e.g. "1. something", followed by 1 or more paragraphs before a "2."
-	// These are not a numeric list, so we buffer potential enumerate blocks until we confirm if it is indeed a enumerate block
-	// otherwise leave the "1." tokens as is
-	is_num_buf := false
-	num_buffer := make([]string, 0)
+	// end of the latex environment of the code block being exported
+	code_end := ""
+	is_math := false
+	// lines left of the table or quote being exported
+	skipped_lines := 0
+
+	// end_lists closes the lists more indented than indent
+	end_lists := func(indent int) {
+		for len(lists) > 0 && lists[len(lists)-1].Indent > indent {
+			note = append(note, strings.Repeat("  ", len(lists)-1)+`\end{`+lists[len(lists)-1].Kind+`}`)
+			lists = lists[:len(lists)-1]
+		}
+	}
 
 	for index, line := range markdown_note {
-		// replace blocks
-		if !is_enumerate && num_re.MatchString(line) {
-			is_enumerate = true
-			is_num_buf = true
-			num_buffer = append(num_buffer, `\begin{enumerate}` + "\n")
-		} else if !is_itemize && item_re.MatchString(line) {
-			is_itemize = true
-			note = append(note, `\begin{itemize}` + "\n")
-		} else if !is_verbatim && line == "```" {
-			is_verbatim = true
-			note = append(note, `\begin{verbatim}` + "\n")
+		if is_code_fence(line, is_verbatim) {
+			end_lists(-1)
+
+			if is_verbatim {
+				note = append(note, code_end)
+			} else {
+				var code_begin string
+
+				code_begin, code_end = markdown_code_block(code_fence_re.FindStringSubmatch(line)[1], code_environment)
+				note = append(note, code_begin)
+			}
+
+			is_verbatim = !is_verbatim
 			continue
 		}
 
-		if (is_enumerate || is_itemize) && newline_re.MatchString(line) {
+		if is_verbatim {
+			note = append(note, line)
 			continue
 		}
 
-		if is_enumerate && !num_re.MatchString(line) {
-			is_enumerate = false
-			// if the "enum" block has ended during the buffering phase, then it is a fake enumerate block
-			if is_num_buf {
-				note = append(note, num_buffer[1])
-				note = append(note, `\\` + "\n")
-				num_buffer = nil
-				is_num_buf = false
-			} else {
-				note = append(note, "\n" + `\end{enumerate}` + "\n")
-			}
-		} else if is_itemize && !item_re.MatchString(line) {
-			is_itemize = false
-			note = append(note, "\n" + `\end{itemize}` + "\n")
-		} else if is_verbatim && line == "```" {
-			is_verbatim = false
-			note = append(note, "\n" + `\end{verbatim}` + "\n")
-			if len(markdown_note) == index + 1 {
-				// if this is the last line on the note, return here to avoid adding a \\ at the end of a verbatim block (which would be invalid latex syntax)
-				return note
+		// display math written as lines between $$ markers
+		if strings.TrimSpace(line) == "$$" {
+			end_lists(-1)
+
+			if is_math {
+				note = append(note, `\]`)
 			} else {
-				continue
+				note = append(note, `\[`)
 			}
+
+			is_math = !is_math
+			continue
 		}
 
-		if is_enumerate {
-			if !is_num_buf {
-				line = num_re.ReplaceAllString(line, fmt.Sprintf("\t\\item %s", "$1"))
+		if is_math {
+			// an empty line is not valid in latex math
+			if !blank_line_re.MatchString(line) {
+				note = append(note, line)
 			}
-		} else if is_itemize {
-			line = item_re.ReplaceAllString(line, fmt.Sprintf("\t\\item %s", "$1"))
-		} else if is_verbatim {
-			note = append(note, line)
 			continue
 		}
 
-		bold_matches := bold_re.FindAllString(line, -1)
+		if skipped_lines > 0 {
+			skipped_lines--
+			continue
+		}
+
+		if is_table_start(markdown_note[index:]) {
+			end_lists(-1)
 
-		if len(bold_matches) > 0 {
-			non_bold_matches := bold_re.Split(line, -1)
+			table, rows := markdown_table_to_latex(markdown_note[index:])
 
-			line = non_bold_matches[0]
-			non_match_index := 1
+			note = append(note, table...)
+			skipped_lines = rows - 1
+			continue
+		}
 
-			for _, match := range bold_matches {
-				bold_text := bold_re.FindStringSubmatch(match)[1]
+		if quote := quote_line_re.FindStringSubmatch(line); quote != nil {
+			indent := len(quote[1])
 
-				line = line + `\textbf{` + bold_text + `}` + non_bold_matches[non_match_index]
+			// a quote indented under a list item is part of the item, otherwise it ends the lists
+			end_lists(indent - 1)
 
-				if non_match_index < len(non_bold_matches) - 1 {
-					non_match_index = non_match_index + 1
+			quote_latex, rows := markdown_quote_to_latex(markdown_note[index:], code_environment)
+
+			for _, quote_line := range quote_latex {
+				if quote_line != "" {
+					quote_line = strings.Repeat("  ", len(lists)) + quote_line
 				}
+
+				note = append(note, quote_line)
 			}
+
+			skipped_lines = rows - 1
+			continue
+		}
+
+		item := list_item_re.FindStringSubmatch(line)
+
+		// Some notes have sections written as e.g. "1. something", followed by 1 or more paragraphs before a "2."
+		// These are not a numeric list, so a list only starts with a number if another item follows it,
+		// otherwise the "1." tokens are left as is
+		if item != nil && len(lists) == 0 && item[2] != "*" && !next_is_list_item(markdown_note[index+1:]) {
+			item = nil
 		}
 
-		url_matches := url_re.FindAllString(line, -1)
+		if item == nil {
+			// blank lines between items do not end the list
+			if len(lists) > 0 && blank_line_re.MatchString(line) && next_is_list_item(markdown_note[index+1:]) {
+				continue
+			}
+
+			end_lists(-1)
 
-		// we do not want to replace any symbols that are part of an URL
-		line = escape_special_chars(line)
+			// a line break can not follow a block, e.g. a list or quote, so the blank line following one is left empty
+			if blank_line_re.MatchString(line) && len(note) > 0 && block_end_re.MatchString(note[len(note)-1]) {
+				note = append(note, "")
+				continue
+			}
+
+			// an image with a caption is a figure, which latex does not accept in lists or quotes
+			if figure, is_figure := markdown_figure_to_latex(line); is_figure && !is_quote {
+				note = append(note, figure...)
+				continue
+			}
+
+			note = append(note, markdown_line_to_latex(line))
+			continue
+		}
 
-		if len(url_matches) > 0 {
-			non_url_matches := url_re.Split(line, -1)
+		indent := len(item[1])
 
-			line = non_url_matches[0]
-			non_match_index := 1
+		kind := "itemize"
+		if item[2] != "*" {
+			kind = "enumerate"
+		}
 
-			for _, match := range url_matches {
-				url_parts := url_re.FindStringSubmatch(match)
+		end_lists(indent)
 
-				url_text := url_parts[1]
-				url_url := url_parts[2]
+		// a different kind of item at the same indentation starts a new list
+		if len(lists) > 0 && lists[len(lists)-1].Indent == indent && lists[len(lists)-1].Kind != kind {
+			end_lists(indent - 1)
+		}
 
-				if url_text == url_url {
-					line = line + `\url{` + url_url + `}` + non_url_matches[non_match_index]
-				} else {
-					line = line + `\url{` + url_url + `} (` + url_text + `)` + non_url_matches[non_match_index]
-				}
+		if len(lists) == 0 || lists[len(lists)-1].Indent < indent {
+			note = append(note, strings.Repeat("  ", len(lists))+`\begin{`+kind+`}`)
+			lists = append(lists, markdown_list{kind, indent})
+		}
 
-				if non_match_index < len(non_url_matches) - 1 {
-					non_match_index = non_match_index + 1
-				}
+		note = append(note, strings.Repeat("  ", len(lists)-1)+`\item `+markdown_line_to_latex(item[3]))
+	}
+
+	end_lists(-1)
+
+	return note
+}
+
+var table_separator_re = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+
+// is_table_start returns whether the lines start with a pipe table, i.e. a header row followed by a separator row
+func is_table_start(lines []string) bool {
+	return len(lines) > 1 && strings.Contains(lines[0], "|") && strings.Contains(lines[1], "-") && table_separator_re.MatchString(lines[1])
+}
+
+// table_cells splits a pipe table row into its cells. The pipes around the row may be left out, and neither escaped
+// pipes nor those within code or math separate cells. An escaped pipe is a pipe of the cell, even within code or math
+func table_cells(row string) []string {
+	row = strings.TrimSpace(row)
+	row = strings.TrimPrefix(row, "|")
+
+	if strings.HasSuffix(row, "|") && !strings.HasSuffix(row, `\|`) {
+		row = row[:len(row)-1]
+	}
+
+	cells := make([]string, 0)
+
+	add_cell := func(cell string) {
+		cells = append(cells, strings.ReplaceAll(strings.TrimSpace(cell), `\|`, "|"))
+	}
+
+	start := 0
+
+	for index := 0; index < len(row); index++ {
+		switch row[index] {
+		case '\\':
+			index++
+		case '`':
+			if code_end := strings.IndexByte(row[index+1:], '`'); code_end >= 0 {
+				index += code_end + 1
+			}
+		case '$':
+			if _, next_index, found := markdown_math(row, index); found {
+				index = next_index - 1
 			}
+		case '|':
+			add_cell(row[start:index])
+			start = index + 1
 		}
+	}
 
-		line = newline_re.ReplaceAllString(line, `\\` + "\n")
-		line = strings.ReplaceAll(line, `\*`, `*`)
+	add_cell(row[start:])
 
-		if is_num_buf {
-			num_buffer = append(num_buffer, line)
-			// If the buffer has more than two lines (begin + 1. line), then it is a real enumerate block
-			if len(num_buffer) > 2 {
-				for _, num := range num_buffer {
-					note = append(note, num_re.ReplaceAllString(num, fmt.Sprintf("\t\\item %s", "$1")))
-				}
-				is_num_buf = false
-				num_buffer = nil
+	return cells
+}
+
+// markdown_table_to_latex writes the pipe table the lines start with as a tabular, with the column alignments of the
+// separator row. It returns the latex lines along with the number of lines of the table
+func markdown_table_to_latex(lines []string) ([]string, int) {
+	alignments := make([]string, 0)
+
+	for _, cell := range table_cells(lines[1]) {
+		switch {
+		case strings.HasPrefix(cell, ":") && strings.HasSuffix(cell, ":"):
+			alignments = append(alignments, "c")
+		case strings.HasSuffix(cell, ":"):
+			alignments = append(alignments, "r")
+		default:
+			alignments = append(alignments, "l")
+		}
+	}
+
+	row_to_latex := func(row string) string {
+		cells := table_cells(row)
+
+		for index := range cells {
+			cells[index] = markdown_line_to_latex(cells[index])
+		}
 
+		for len(cells) < len(alignments) {
+			cells = append(cells, "")
+		}
+
+		return strings.Join(cells, " & ") + ` \\`
+	}
+
+	latex := []string{`\begin{tabular}{|` + strings.Join(alignments, "|") + `|}`, `\hline`, row_to_latex(lines[0]), `\hline`}
+
+	rows := 2
+
+	for ; rows < len(lines) && strings.Contains(lines[rows], "|"); rows++ {
+		latex = append(latex, row_to_latex(lines[rows]))
+	}
+
+	return append(latex, `\hline`, `\end{tabular}`), rows
+}
+
+var quote_line_re = regexp.MustCompile(`^( *)> ?(.*)$`)
+
+// markdown_quote_to_latex writes the quote the lines start with, i.e. the following lines starting with ">" at the same
+// indentation, as a quote environment. The quoted lines, without their ">", are converted as a note of their own, so
+// they may hold paragraphs, lists, code blocks in the given code_environment or nested quotes. It returns the latex
+// lines along with the number of lines of the quote
+func markdown_quote_to_latex(lines []string, code_environment CodeEnvironment) ([]string, int) {
+	indent := len(quote_line_re.FindStringSubmatch(lines[0])[1])
+
+	quoted := make([]string, 0)
+
+	for _, line := range lines {
+		quote := quote_line_re.FindStringSubmatch(line)
+		if quote == nil || len(quote[1]) != indent {
+			break
+		}
+
+		quoted = append(quoted, quote[2])
+	}
+
+	latex := []string{`\begin{quote}`}
+
+	for _, line := range markdown_text_to_latex(quoted, true, code_environment) {
+		// an empty line breaks the paragraphs of a quote
+		if line == `\\`+"\n" {
+			line = ""
+		}
+
+		latex = append(latex, line)
+	}
+
+	return append(latex, `\end{quote}`), len(quoted)
+}
+
+type markdown_list struct {
+	Kind   string
+	Indent int
+}
+
+var list_item_re = regexp.MustCompile(`^( *)(\*|[0-9]+\.) +(.*)$`)
+
+var blank_line_re = regexp.MustCompile(`^ *$`)
+
+// the last line of a block, i.e. of an environment or display math
+var block_end_re = regexp.MustCompile(`^ *(\\end\{[a-z]+\}|\\\])$`)
+
+var url_re = regexp.MustCompile(`\[([^]]*)\]\(([^)]*)\)`)
+
+// next_is_list_item returns whether the first non blank line is a list item
+func next_is_list_item(lines []string) bool {
+	for _, line := range lines {
+		if !blank_line_re.MatchString(line) {
+			return list_item_re.MatchString(line)
+		}
+	}
+
+	return false
+}
+
+var math_placeholder_re = regexp.MustCompile(`ǂm(\d+)ǂ`)
+
+// placeholder of an escaped dollar sign, which is kept escaped in latex so it is not read as math
+const dollar_placeholder = "ǂdǂ"
+
+// markdown_math_to_placeholders replaces the math of the line, and escaped dollar signs, by placeholders, so they are
+// kept out of the formatting and escaping of the rest of the line. It returns the replaced line along with the latex
+// of each math placeholder. Dollar signs within inline code are not math
+func markdown_math_to_placeholders(line string) (string, []string) {
+	var replaced strings.Builder
+
+	math := make([]string, 0)
+
+	for index := 0; index < len(line); {
+		switch {
+		case strings.HasPrefix(line[index:], `\$`):
+			replaced.WriteString(dollar_placeholder)
+			index += 2
+			continue
+		case line[index] == '\\' && index+1 < len(line):
+			replaced.WriteString(line[index : index+2])
+			index += 2
+			continue
+		case line[index] == '`':
+			if code_end := strings.Index(line[index+1:], "`"); code_end >= 0 {
+				replaced.WriteString(line[index : index+code_end+2])
+				index += code_end + 2
+				continue
+			}
+		case line[index] == '$':
+			if latex, next_index, found := markdown_math(line, index); found {
+				replaced.WriteString(fmt.Sprintf("ǂm%dǂ", len(math)))
+				math = append(math, latex)
+				index = next_index
+				continue
 			}
-		} else {
-			note = append(note, line)
 		}
+
+		replaced.WriteByte(line[index])
+		index++
 	}
 
-	if is_enumerate {
-		// if the "enum" block has not ended during the buffering phase, then it is a real enumerate block
-		if is_num_buf {
-			note = append(note, num_re.ReplaceAllString(num_buffer[1], fmt.Sprintf("\t\\item %s", "$1")))
-			is_num_buf = false
-			num_buffer = nil
+	return replaced.String(), math
+}
+
+// markdown_math returns the latex of the math starting at index, and the index following it. As in pandoc, inline math
+// can not start or end with a space, and its closing $ can not be followed by a digit, so e.g. "$5 and $10" is not
+// math. The boolean return value is false if there is no math at index
+func markdown_math(line string, index int) (string, int, bool) {
+	if strings.HasPrefix(line[index:], "$$") {
+		close_index := strings.Index(line[index+2:], "$$")
+		if close_index <= 0 {
+			return "", index, false
 		}
 
-		note = append(note, "\n" + `\end{enumerate}` + "\n")
-	} else if is_itemize {
-		note = append(note, "\n" + `\end{itemize}`)
-	} 
+		return `\[` + line[index+2:index+2+close_index] + `\]`, index + close_index + 4, true
+	}
 
-	return note
+	content_index := index + 1
+
+	if content_index >= len(line) || line[content_index] == ' ' || line[content_index] == '$' {
+		return "", index, false
+	}
+
+	for close_index := content_index + 1; close_index < len(line); close_index++ {
+		switch {
+		case line[close_index] == '\\':
+			close_index++
+		case line[close_index] == '`':
+			// math does not go on into inline code
+			return "", index, false
+		case line[close_index] == '$' && line[close_index-1] != ' ' && (close_index+1 == len(line) || line[close_index+1] < '0' || line[close_index+1] > '9'):
+			return line[index : close_index+1], close_index + 1, true
+		}
+	}
+
+	return "", index, false
+}
+
+// markdown_line_to_latex converts the inline formatting, links, code and math of a line of text
+func markdown_line_to_latex(line string) string {
+	return markdown_text_line_to_latex(line, false)
+}
+
+// markdown_text_line_to_latex converts a line of text, which is the argument of a command if is_argument, e.g. of a
+// \footnote, so its inline code can not be written as \verb
+func markdown_text_line_to_latex(line string, is_argument bool) string {
+	line, code := markdown_code_to_placeholders(line)
+	line, images := markdown_images_to_placeholders(line)
+	line, math := markdown_math_to_placeholders(line)
+
+	line = markdown_inline_to_latex(line)
+
+	url_matches := url_re.FindAllString(line, -1)
+
+	// we do not want to replace any symbols that are part of an URL
+	line = escape_special_chars(line)
+
+	if len(url_matches) > 0 {
+		non_url_matches := url_re.Split(line, -1)
+
+		line = non_url_matches[0]
+		non_match_index := 1
+
+		for _, match := range url_matches {
+			url_parts := url_re.FindStringSubmatch(match)
+
+			url_text := url_parts[1]
+			url_url := url_parts[2]
+
+			if url_text == url_url {
+				line = line + `\url{` + url_url + `}` + non_url_matches[non_match_index]
+			} else {
+				line = line + `\href{` + escape_href_url(url_url) + `}{` + escape_special_chars(url_text) + `}` + non_url_matches[non_match_index]
+			}
+
+			if non_match_index < len(non_url_matches) - 1 {
+				non_match_index = non_match_index + 1
+			}
+		}
+	}
+
+	line = blank_line_re.ReplaceAllString(line, `\\` + "\n")
+	line = strings.ReplaceAll(line, `\*`, `*`)
+	line = strings.ReplaceAll(line, dollar_placeholder, `\$`)
+
+	// math and images go last, so they are neither formatted nor escaped
+	line = math_placeholder_re.ReplaceAllStringFunc(line, func(placeholder string) string {
+		index, _ := strconv.Atoi(math_placeholder_re.FindStringSubmatch(placeholder)[1])
+
+		if index < len(math) {
+			return math[index]
+		}
+
+		return placeholder
+	})
+
+	line = image_placeholder_re.ReplaceAllStringFunc(line, func(placeholder string) string {
+		index, _ := strconv.Atoi(image_placeholder_re.FindStringSubmatch(placeholder)[1])
+
+		if index < len(images) {
+			return images[index]
+		}
+
+		return placeholder
+	})
+
+	// code goes after every other command, as whether it can be written as \verb depends on the braces around it
+	return code_to_latex(line, code, is_argument)
 }
 
-func Export_to_latex_file(file_path string, section_name string, sub_section_index int, note_list []types.Note) error {
+// Export_to_latex_file writes the notes of a category to a latex file, under a section heading for each category
+// in category_path, from \section for the top category down to \sub...section for the category itself, so that
+// importing the file gives the same category wherever it is. Note dates are written using the date_layout time format,
+// accented letters and typographic characters as given by encoding, and code blocks with a language in the given
+// code_environment. The note metadata other than the title, url, dates and tags follows them, in the order given by
+// utils.Metadata_order. The images of the notes are written next to the file, at the path the notes refer to them
+// with. Footnotes referenced but not defined are reported once the file is written
+func Export_to_latex_file(file_path string, category_path string, note_list []types.Note, date_layout string, encoding TextEncoding, code_environment CodeEnvironment) error {
 	fmt.Println("Processing " + file_path)
 
+	// encode returns the latex of an exported line with the characters written as given by encoding
+	encode := func(line string) string {
+		if encoding == AsciiText {
+			return unicode_to_latex(line)
+		}
+
+		return line
+	}
+
+	if err := write_note_images(filepath.Dir(file_path), note_list); err != nil {
+		return err
+	}
+
 	var f *os.File
 	var err error
 
 	if f, err = os.Create(file_path); err != nil {
-		log.Fatalf("Error creating file %s: %v", file_path, err)
+		return fmt.Errorf("error creating file %s: %w", file_path, err)
 	}
 
 	defer f.Close()
 
 	writer := bufio.NewWriter(f)
 
-	if _, err = writer.WriteString(`\` + strings.Repeat("sub", sub_section_index) + `section{` + escape_special_chars(section_name) + `}` + "\n\n"); err != nil {
+	for depth, name := range strings.Split(category_path, utils.Category_separator) {
+		if _, err = writer.WriteString(`\` + strings.Repeat("sub", depth) + `section{` + encode(escape_special_chars(name)) + `}` + "\n"); err != nil {
+			return err
+		}
+	}
+
+	if _, err = writer.WriteString("\n"); err != nil {
 		return err
 	}
 
+	// notes referencing undefined footnotes are still exported, keeping the references as text, and reported at the end
+	footnote_errors := make([]error, 0)
+
 	for _, note := range(note_list) {
-		if _, err = writer.WriteString(`\textbf{Title:} ` + escape_special_chars(note.Title) + `\\` + "\n"); err != nil {
+		if undefined := undefined_footnotes(note.Text); len(undefined) > 0 {
+			footnote_errors = append(footnote_errors, fmt.Errorf("note %q: footnotes referenced but not defined: [^%s]", note.Title, strings.Join(undefined, "], [^")))
+		}
+
+		if _, err = writer.WriteString(`\textbf{Title:} ` + encode(escape_special_chars(note.Title)) + `\\` + "\n"); err != nil {
 			return err
 		}
 
@@ -204,15 +646,37 @@ func Export_to_latex_file(file_path string, section_name string, sub_section_ind
 			return err
 		}
 
-		if _, err = writer.WriteString(`\textbf{Created:} ` + note.Created_date + `\\` + "\n"); err != nil {
+		if _, err = writer.WriteString(`\textbf{Created:} ` + note.Created_date.Format(date_layout) + `\\` + "\n"); err != nil {
+			return err
+		}
+
+		if _, err = writer.WriteString(`\textbf{Last Updated:} ` + note.Updated_date.Format(date_layout) + `\\` + "\n"); err != nil {
 			return err
 		}
 
-		if _, err = writer.WriteString(`\textbf{Last Updated:} ` + note.Updated_date + `\\` + "\n" + `\\` + "\n"); err != nil {
+		if len(note.Tags) > 0 {
+			if _, err = writer.WriteString(`\textbf{Tags:} ` + encode(escape_special_chars(strings.Join(note.Tags, ", "))) + `\\` + "\n"); err != nil {
+				return err
+			}
+		}
+
+		for _, key := range utils.Metadata_order(note.Metadata) {
+			if _, err = writer.WriteString(`\textbf{` + encode(escape_special_chars(key)) + `:} ` + encode(metadata_value_to_latex(note.Metadata[key])) + `\\` + "\n"); err != nil {
+				return err
+			}
+		}
+
+		if _, err = writer.WriteString(`\\` + "\n"); err != nil {
 			return err
 		}
 
-		for _, content := range markdown_note_to_latex(note.Text) {
+		latex_note := markdown_note_to_latex(note.Text, code_environment)
+
+		if encoding == AsciiText {
+			latex_note = latex_note_to_ascii(latex_note)
+		}
+
+		for _, content := range latex_note {
 			if _, err = writer.WriteString(content + "\n"); err != nil {
 				return err
 			}
@@ -223,5 +687,9 @@ func Export_to_latex_file(file_path string, section_name string, sub_section_ind
 		}
 	}
 
-	return writer.Flush()
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+
+	return errors.Join(footnote_errors...)
 }
@@ -6,7 +6,6 @@ import (
 	"cotonetes/utils"
 	"log"
 	"os"
-	"strings"
 	"testing"
 )
 
@@ -17,7 +16,7 @@ func LatexToCotonetes(t *testing.T, note types.Note) ([]string, string) {
 	folder_path := t.TempDir()
 	file_path := folder_path + "/test.tex"
 
-	err := Export_to_latex_file(file_path, "test", 0, []types.Note{note})
+	err := Export_to_latex_file(file_path, "test", []types.Note{note}, utils.TdDateLayout, UnicodeText, AutoCode)
 
 	utils.FailNotEquals(t, "Failed export", nil, err)
 
@@ -54,7 +53,7 @@ func baseMarkdownParserTest(t *testing.T, test_input utils.TestInput) {
 		"",
 	}
 
-	expected_content = append(expected_content, strings.Join(utils.NoteToLatex(test_input.Latex), "\n"))
+	expected_content = append(expected_content, utils.NoteToLatex(test_input.Latex)...)
 
 	utils.FailNotEqualsSlice(t, "Failed to process note content line", expected_content, output_file_contents)
 }
@@ -75,10 +74,18 @@ func TestBoldString(t *testing.T) {
 	baseMarkdownParserTest(t, utils.TdNoteBoldText)
 }
 
+func TestInlineFormatting(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteInlineFormatting)
+}
+
 func TestUrl(t *testing.T) {
 	baseMarkdownParserTest(t, utils.TdNoteUrl)
 }
 
+func TestHref(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteHref)
+}
+
 func TestNewline(t *testing.T) {
 	baseMarkdownParserTest(t, utils.TdNoteNewline)
 }
@@ -91,6 +98,63 @@ func TestEnumerate(t *testing.T) {
 	baseMarkdownParserTest(t, utils.TdNoteEnumerate)
 }
 
+func TestNestedList(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteNestedList)
+}
+
+func TestMath(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteMath)
+}
+
+func TestTable(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteTable)
+}
+
+func TestTablePipes(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteTablePipes)
+
+	// pipes within code or math do not separate cells, even if not escaped
+	markdown := utils.TdNoteTablePipes.Markdown
+	markdown.Text = []string{`| Math | Code |`, `| --- | --- |`, "| $|x|$ | `a|b` |"}
+
+	baseMarkdownParserTest(t, utils.TestInput{Latex: utils.TdNoteTablePipes.Latex, Markdown: markdown})
+}
+
+func TestQuote(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteQuote)
+}
+
+func TestImage(t *testing.T) {
+	_, folder_path := LatexToCotonetes(t, utils.TdNoteImage.Markdown)
+
+	for path, data := range utils.TdNoteImage.Markdown.Images {
+		written, err := os.ReadFile(folder_path + "/" + path)
+
+		utils.FailNotEquals(t, "Failed to write image "+path, nil, err)
+		utils.FailNotEquals(t, "Failed to write image contents "+path, string(data), string(written))
+	}
+
+	baseMarkdownParserTest(t, utils.TdNoteImage)
+}
+
+func TestFootnote(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteFootnote)
+}
+
+func TestMetadata(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteMetadata)
+}
+
+func TestCode(t *testing.T) {
+	baseMarkdownParserTest(t, utils.TdNoteCode)
+}
+
 func TestVerbatim(t *testing.T) {
 	baseMarkdownParserTest(t, utils.TdNoteVerbatim)
 }
+
+func TestDollarSigns(t *testing.T) {
+	latex := markdown_note_to_latex([]string{"costs $5 and $10, or `$x$`"}, AutoCode)
+
+	utils.FailNotEqualsSlice(t, "Failed to escape dollar signs that are not math", []string{`costs \$5 and \$10, or \verb|$x$|`}, latex)
+}
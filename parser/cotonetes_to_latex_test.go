@@ -32,10 +32,25 @@ func TestMdLxBoldString(t *testing.T) {
 	mdLxParserTest(t, utils.TdNoteBoldText)
 }
 
+func TestMdLxInlineFormatting(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteInlineFormatting)
+}
+
 func TestMdLxUrl(t *testing.T) {
 	mdLxParserTest(t, utils.TdNoteUrl)
 }
 
+func TestMdLxHref(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteHref)
+}
+
+func TestMdLxHrefPunctuation(t *testing.T) {
+	markdown := utils.TdNoteHref.Markdown
+	markdown.Text = []string{`see [text](https://a.b/c). Next, [https://a.b](https://a.b)... and ([more](https://a.b/d)).`}
+
+	mdLxParserTest(t, utils.TestInput{Latex: utils.TdNoteHref.Latex, Markdown: markdown})
+}
+
 func TestMdLxNewline(t *testing.T) {
 	mdLxParserTest(t, utils.TdNoteNewline)
 }
@@ -43,3 +58,50 @@ func TestMdLxNewline(t *testing.T) {
 func TestMdLxItemize(t *testing.T) {
 	mdLxParserTest(t, utils.TdNoteItemize)
 }
+
+func TestMdLxNestedList(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteNestedList)
+}
+
+func TestMdLxMath(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteMath)
+}
+
+func TestMdLxTable(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteTable)
+}
+
+func TestMdLxTablePipes(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteTablePipes)
+}
+
+func TestMdLxQuote(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteQuote)
+}
+
+func TestMdLxImage(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteImage)
+}
+
+func TestMdLxFootnote(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteFootnote)
+}
+
+func TestMdLxCode(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteCode)
+}
+
+func TestMdLxAccents(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteAccents)
+}
+
+func TestMdLxMetadata(t *testing.T) {
+	mdLxParserTest(t, utils.TdNoteMetadata)
+}
+
+func TestMdLxMetadataFormatting(t *testing.T) {
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Metadata = map[string]string{"Source": "The *Book*, 50% off", "Link": "[site](https://a.b)"}
+
+	mdLxParserTest(t, utils.TestInput{Latex: utils.TdTextOnly.Latex, Markdown: markdown})
+}
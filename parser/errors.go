@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"github.com/antlr4-go/antlr/v4"
+	"strings"
+)
+
+// ParseError is returned when a note can not be parsed. Line is the line number in the file where the
+// error was found, Title is the title of the note being parsed, if already known. Column is only known for
+// syntax errors, starting at 1
+type ParseError struct {
+	File_path string
+	Title     string
+	Line      int
+	Column    int
+	Err       error
+}
+
+func (e *ParseError) Error() string {
+	position := fmt.Sprintf("%s:%d", e.File_path, e.Line)
+	if e.Column > 0 {
+		position += fmt.Sprintf(":%d", e.Column)
+	}
+
+	if e.Title != "" {
+		return fmt.Sprintf("%s: note %q: %v", position, e.Title, e.Err)
+	}
+
+	return fmt.Sprintf("%s: %v", position, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors holds every error found while parsing, e.g. all the syntax errors of a note, or the errors of
+// the notes skipped by a non strict import
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	messages := make([]string, 0, len(e))
+
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// parse_errors returns the errors held by err, which is either a ParseError or ParseErrors
+func parse_errors(err error) (ParseErrors, bool) {
+	var parse_errs ParseErrors
+	if errors.As(err, &parse_errs) {
+		return parse_errs, true
+	}
+
+	var parse_err *ParseError
+	if errors.As(err, &parse_err) {
+		return ParseErrors{parse_err}, true
+	}
+
+	return nil, false
+}
+
+// syntax_error_listener records the syntax errors found by the lexer and parser, instead of printing them to the console.
+// Lines are those of the parsed text, columns start at 0
+type syntax_error_listener struct {
+	*antlr.DefaultErrorListener
+
+	errors []ParseError
+}
+
+// SyntaxError records the error. An environment still open at the end of the note, e.g. a list missing its \end, is
+// reported where it begins
+func (l *syntax_error_listener) SyntaxError(recognizer antlr.Recognizer, offending_symbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	token, is_token := offending_symbol.(antlr.Token)
+	parser, is_parser := recognizer.(antlr.Parser)
+
+	if is_token && is_parser && token.GetTokenType() == antlr.TokenEOF && parser.GetParserRuleContext() != nil {
+		if begin := parser.GetParserRuleContext().GetStart(); strings.HasPrefix(begin.GetText(), `\begin{`) {
+			l.errors = append(l.errors, ParseError{Line: begin.GetLine(), Column: begin.GetColumn(), Err: fmt.Errorf("%s is not closed", begin.GetText())})
+			return
+		}
+	}
+
+	l.errors = append(l.errors, ParseError{Line: line, Column: column, Err: errors.New(msg)})
+}
@@ -0,0 +1,130 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// placeholder of a footnote reference, holding the footnote number, kept out of the formatting and escaping of the
+// exported line
+var footnote_placeholder_re = regexp.MustCompile(`ǂf(\d+)ǂ`)
+
+var markdown_footnote_re = regexp.MustCompile(`\[\^([^\]\s]+)\]`)
+
+var markdown_footnote_definition_re = regexp.MustCompile(`^\[\^([^\]\s]+)\]:\s*(.*)$`)
+
+// markdown_footnotes returns the ids of the footnotes referenced by the text, in order and without duplicates, along
+// with the text of each footnote defined by a "[^id]: text" line. Verbatim blocks are left out
+func markdown_footnotes(text []string) ([]string, map[string]string) {
+	references := make([]string, 0)
+	definitions := make(map[string]string)
+
+	is_verbatim := false
+
+	for _, line := range text {
+		if is_code_fence(line, is_verbatim) {
+			is_verbatim = !is_verbatim
+		}
+
+		if is_verbatim {
+			continue
+		}
+
+		if definition := markdown_footnote_definition_re.FindStringSubmatch(line); definition != nil {
+			definitions[definition[1]] = definition[2]
+			line = line[len(definition[0])-len(definition[2]):]
+		}
+
+		for _, reference := range markdown_footnote_re.FindAllStringSubmatch(line, -1) {
+			if !slices.Contains(references, reference[1]) {
+				references = append(references, reference[1])
+			}
+		}
+	}
+
+	return references, definitions
+}
+
+// undefined_footnotes returns the ids of the footnotes referenced by the text but not defined in it
+func undefined_footnotes(text []string) []string {
+	references, definitions := markdown_footnotes(text)
+
+	undefined := make([]string, 0)
+
+	for _, id := range references {
+		if _, found := definitions[id]; !found {
+			undefined = append(undefined, id)
+		}
+	}
+
+	return undefined
+}
+
+// markdown_footnotes_to_placeholders removes the definitions of the referenced footnotes from the text, along with the
+// blank lines left before them at the end, and replaces their references by placeholders. It returns the text along
+// with the latex of each footnote. References to undefined footnotes, and definitions never referenced, are kept
+func markdown_footnotes_to_placeholders(text []string) ([]string, []string) {
+	references, definitions := markdown_footnotes(text)
+
+	// number of each footnote, starting at 1, by id
+	numbers := make(map[string]int)
+	footnotes := make([]string, 0)
+
+	for _, id := range references {
+		if definition, found := definitions[id]; found {
+			footnotes = append(footnotes, markdown_text_line_to_latex(definition, true))
+			numbers[id] = len(footnotes)
+		}
+	}
+
+	if len(footnotes) == 0 {
+		return text, footnotes
+	}
+
+	replaced := make([]string, 0, len(text))
+	is_verbatim := false
+
+	for _, line := range text {
+		if is_code_fence(line, is_verbatim) {
+			is_verbatim = !is_verbatim
+		}
+
+		if is_verbatim {
+			replaced = append(replaced, line)
+			continue
+		}
+
+		if definition := markdown_footnote_definition_re.FindStringSubmatch(line); definition != nil && numbers[definition[1]] > 0 {
+			continue
+		}
+
+		replaced = append(replaced, markdown_footnote_re.ReplaceAllStringFunc(line, func(reference string) string {
+			if number := numbers[markdown_footnote_re.FindStringSubmatch(reference)[1]]; number > 0 {
+				return fmt.Sprintf("ǂf%dǂ", number)
+			}
+
+			return reference
+		}))
+	}
+
+	for len(replaced) > 0 && blank_line_re.MatchString(replaced[len(replaced)-1]) {
+		replaced = replaced[:len(replaced)-1]
+	}
+
+	return replaced, footnotes
+}
+
+// footnotes_to_latex replaces the footnote placeholders of a latex line by the footnotes
+func footnotes_to_latex(line string, footnotes []string) string {
+	return footnote_placeholder_re.ReplaceAllStringFunc(line, func(placeholder string) string {
+		number, _ := strconv.Atoi(footnote_placeholder_re.FindStringSubmatch(placeholder)[1])
+
+		if number >= 1 && number <= len(footnotes) {
+			return `\footnote{` + footnotes[number-1] + `}`
+		}
+
+		return placeholder
+	})
+}
@@ -0,0 +1,26 @@
+package parser
+
+import (
+	"cotonetes/types"
+	"cotonetes/utils"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestUndefinedFootnotes(t *testing.T) {
+	file_path := t.TempDir() + "/test.tex"
+
+	note := utils.TdNoteFootnote.Markdown
+	note.Text = []string{"a remark[^1] and another[^missing]", "```", "[^code]", "```", "", "[^1]: defined"}
+
+	err := Export_to_latex_file(file_path, "test", []types.Note{note}, utils.TdDateLayout, UnicodeText, AutoCode)
+
+	utils.FailNotEquals(t, "Failed to report undefined footnote", true, err != nil && strings.Contains(err.Error(), "[^missing]"))
+	utils.FailNotEquals(t, "Failed to leave out verbatim footnote", false, strings.Contains(err.Error(), "code"))
+
+	latex, read_err := os.ReadFile(file_path)
+
+	utils.FailNotEquals(t, "Failed to write the file", nil, read_err)
+	utils.FailNotEquals(t, "Failed to export defined footnote", true, strings.Contains(string(latex), `a remark\footnote{defined} and another[\^{}missing]`))
+}
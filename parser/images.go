@@ -0,0 +1,204 @@
+package parser
+
+import (
+	"cotonetes/types"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var includegraphics_re = regexp.MustCompile(`\\includegraphics\*?(?:\[[^]]*\])?\{([^}]*)\}`)
+
+var figure_begin_re = regexp.MustCompile(`^\s*\\begin\{(figure\*?)\}(?:\[[^]]*\])?`)
+
+var markdown_image_re = regexp.MustCompile(`!\[([^]]*)\]\(([^)\s]*)\)`)
+
+// add_image keeps the path of an image, returning its markdown, which the grammar reads as punctuation around the
+// caption and a url placeholder
+func (f *inline_formatter) add_image(caption string, path string) string {
+	f.urls = append(f.urls, strings.TrimSpace(path))
+
+	return "![" + caption + fmt.Sprintf("](ǂu%dǂ)", len(f.urls)-1)
+}
+
+// replace_figure returns the markdown of the images of a figure environment, all of them in a single line, the first
+// one along with the figure caption. Anything else in the figure, e.g. \centering or \label, is left out
+func (f *inline_formatter) replace_figure(content string) (string, error) {
+	images := includegraphics_re.FindAllStringSubmatch(content, -1)
+
+	if len(images) == 0 {
+		return "", errors.New(`figure without \includegraphics`)
+	}
+
+	caption := ""
+
+	if caption_index := strings.Index(content, `\caption`); caption_index >= 0 {
+		argument_index := caption_index + len(`\caption`)
+
+		// the short caption, for the list of figures, is left out
+		if strings.HasPrefix(content[argument_index:], "[") {
+			if close_index := strings.IndexByte(content[argument_index:], ']'); close_index >= 0 {
+				argument_index += close_index + 1
+			}
+		}
+
+		text, _, found := command_argument(content, argument_index)
+		if !found {
+			return "", errors.New(`\caption is not closed`)
+		}
+
+		caption = strings.TrimSpace(text)
+	}
+
+	markdown := make([]string, 0, len(images))
+
+	for index, image := range images {
+		if index > 0 {
+			caption = ""
+		}
+
+		markdown = append(markdown, f.add_image(caption, image[1]))
+	}
+
+	return strings.Join(markdown, " "), nil
+}
+
+// note_image_paths returns the paths of the images shown in the markdown text of a note, in order and without duplicates
+func note_image_paths(text []string) []string {
+	paths := make([]string, 0)
+
+	for _, line := range text {
+		for _, image := range markdown_image_re.FindAllStringSubmatch(line, -1) {
+			if !slices.Contains(paths, image[2]) {
+				paths = append(paths, image[2])
+			}
+		}
+	}
+
+	return paths
+}
+
+// read_note_images reads the image files shown in the note, whose paths are relative to folder_path, as latex reads them
+// from the folder of the file being compiled. The returned ParseError line is that of the note line showing the image
+func read_note_images(note *types.Note, folder_path string, latex_note []string) *ParseError {
+	paths := note_image_paths(note.Text)
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	// image_line returns the line of the note showing the image
+	image_line := func(path string) int {
+		for index, line := range latex_note {
+			if strings.Contains(line, "{"+path+"}") {
+				return index + 1
+			}
+		}
+
+		return 1
+	}
+
+	note.Images = make(map[string][]byte, len(paths))
+
+	for _, path := range paths {
+		// the image is exported to the same path, which must stay within the export folder
+		if !filepath.IsLocal(filepath.FromSlash(path)) {
+			return &ParseError{Title: note.Title, Line: image_line(path), Err: fmt.Errorf("image %s must be within the folder of the file", path)}
+		}
+
+		image_path := image_file_path(folder_path, path)
+
+		data, err := os.ReadFile(filepath.Join(folder_path, filepath.FromSlash(image_path)))
+		if err != nil {
+			return &ParseError{Title: note.Title, Line: image_line(path), Err: fmt.Errorf("missing image file: %w", err)}
+		}
+
+		// the note shows the image by the path of its file, with its extension
+		if image_path != path {
+			for index, line := range note.Text {
+				note.Text[index] = strings.ReplaceAll(line, "]("+path+")", "]("+image_path+")")
+			}
+		}
+
+		note.Images[image_path] = data
+	}
+
+	return nil
+}
+
+// extensions of the image files tried, in order, for an image path without extension
+var image_extensions = []string{".png", ".jpg", ".jpeg", ".pdf"}
+
+// image_file_path returns the path, relative to folder_path, of the file of the image shown with path. As latex does,
+// the extension may be left out, the path of the first existing file with one of image_extensions being returned.
+// The path is returned as is if it has an extension or no such file exists
+func image_file_path(folder_path string, path string) string {
+	if filepath.Ext(path) != "" {
+		return path
+	}
+
+	for _, extension := range image_extensions {
+		if _, err := os.Stat(filepath.Join(folder_path, filepath.FromSlash(path+extension))); err == nil {
+			return path + extension
+		}
+	}
+
+	return path
+}
+
+// write_note_images writes the image files of the notes under folder_path, at the path their notes refer to them with
+func write_note_images(folder_path string, note_list []types.Note) error {
+	for _, note := range note_list {
+		for path, data := range note.Images {
+			image_path := filepath.Join(folder_path, filepath.FromSlash(path))
+
+			if err := os.MkdirAll(filepath.Dir(image_path), 0755); err != nil {
+				return fmt.Errorf("error creating folder for image %s: %w", image_path, err)
+			}
+
+			if err := os.WriteFile(image_path, data, 0644); err != nil {
+				return fmt.Errorf("error writing image %s: %w", image_path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// markdown_images_to_placeholders replaces the images of a markdown line by placeholders, so their paths are kept out
+// of the escaping of the rest of the line. It returns the replaced line along with the latex of each placeholder
+func markdown_images_to_placeholders(line string) (string, []string) {
+	images := make([]string, 0)
+
+	line = markdown_image_re.ReplaceAllStringFunc(line, func(image string) string {
+		images = append(images, `\includegraphics{`+markdown_image_re.FindStringSubmatch(image)[2]+`}`)
+
+		return fmt.Sprintf("ǂg%dǂ", len(images)-1)
+	})
+
+	return line, images
+}
+
+var image_placeholder_re = regexp.MustCompile(`ǂg(\d+)ǂ`)
+
+// markdown_figure_to_latex returns the figure environment of a line holding only an image with a caption. The
+// boolean return value is false for any other line
+func markdown_figure_to_latex(line string) ([]string, bool) {
+	image := markdown_image_re.FindStringSubmatchIndex(line)
+
+	if image == nil || strings.TrimSpace(line[:image[0]]+line[image[1]:]) != "" || image[3] == image[2] {
+		return nil, false
+	}
+
+	return []string{
+		`\begin{figure}[h]`,
+		`  \centering`,
+		`  \includegraphics[width=\linewidth]{` + line[image[4]:image[5]] + `}`,
+		`  \caption{` + markdown_text_line_to_latex(line[image[2]:image[3]], true) + `}`,
+		`\end{figure}`,
+	}, true
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"cotonetes/utils"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// setupImageTest writes the note along with the image files it shows
+func setupImageTest(t *testing.T, test_input utils.TestInput) string {
+	folder_path, _ := setupTest(t, test_input.Latex)
+
+	for path, data := range test_input.Markdown.Images {
+		writeLatexFile(t, folder_path+"/"+path, []string{string(data)})
+	}
+
+	return folder_path
+}
+
+func TestNoteImage(t *testing.T) {
+	LatexParserTest(t, setupImageTest(t, utils.TdNoteImage), utils.TdNoteImage.Markdown)
+}
+
+func TestNoteFigureVariants(t *testing.T) {
+	test_input := utils.TdNoteImage
+	test_input.Latex.Text = []string{
+		`click the \includegraphics*[scale=0.5]{images/button_icon.png} button`,
+		`\begin{figure*}`,
+		`\includegraphics{images/build.png}\caption[build]{the \textbf{build} output}\label{fig:build}`,
+		`\end{figure*} after the figure`,
+	}
+
+	LatexParserTest(t, setupImageTest(t, test_input), test_input.Markdown)
+}
+
+func TestNoteImageWithoutExtension(t *testing.T) {
+	test_input := utils.TdNoteImage
+	test_input.Latex.Text = []string{
+		`click the \includegraphics{images/button_icon} button`,
+		`\begin{figure}`,
+		`\includegraphics{images/build}\caption{the \textbf{build} output}`,
+		`\end{figure}`,
+		`after the figure`,
+	}
+
+	// the first existing file is read, trying .png, .jpg, .jpeg and .pdf
+	test_input.Markdown.Text = []string{`click the ![](images/button_icon.png) button`, `![the **build** output](images/build.jpeg)`, `after the figure`}
+	test_input.Markdown.Images = map[string][]byte{"images/button_icon.png": []byte("button icon"), "images/build.jpeg": []byte("build output")}
+
+	LatexParserTest(t, setupImageTest(t, test_input), test_input.Markdown)
+}
+
+func TestMissingImageError(t *testing.T) {
+	test_input := utils.TdNoteImage
+	test_input.Markdown.Images = map[string][]byte{"images/button_icon.png": []byte("button icon")}
+
+	_, err := Process_files(setupImageTest(t, test_input), "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report image line number", 9, parse_err.Line)
+	utils.FailNotEquals(t, "Failed to report note title", test_input.Latex.Title, parse_err.Title)
+	utils.FailNotEquals(t, "Failed to report missing image", true, strings.Contains(parse_err.Error(), "images/build.png"))
+}
+
+func TestFigureWithoutImageError(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{`\begin{figure}`, `\caption{nothing to show}`, `\end{figure}`}
+
+	folder_path, _ := setupTest(t, latex)
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report figure line number", 6, parse_err.Line)
+}
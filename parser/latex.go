@@ -1,68 +1,118 @@
 package parser
 
 import (
-	"bufio"
 	"cotonetes/latex_parser"
 	"cotonetes/types"
+	"cotonetes/utils"
+	"errors"
 	"fmt"
 	"github.com/antlr4-go/antlr/v4"
-	"log"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 type FileNotes struct {
 	File_path string
 	Notes     []types.Note
+	// section headings preceding each note, indexed as Notes, from \section down to the innermost \sub...section.
+	// Headings missing above the first one found, e.g. in a file starting with a \subsection, are empty
+	Sections [][]string
 }
 
-func escape_special_chars_to_markdown(line string) string {
-	special_re := regexp.MustCompile(`\\(.)`)
+// NoteCategory returns the category of the note at index, i.e. the path of its section headings. Missing headings are
+// taken from folder_category, the category of the folder holding the file, which is also the category of notes
+// without headings
+func (f FileNotes) NoteCategory(index int, folder_category string) string {
+	sections := f.Sections[index]
 
-	return special_re.ReplaceAllString(line, "$1")
+	if len(sections) == 0 {
+		return folder_category
+	}
+
+	var folder_names []string
+	if folder_category != "" {
+		folder_names = strings.Split(folder_category, utils.Category_separator)
+	}
+
+	names := make([]string, 0, len(sections))
+
+	for depth, name := range sections {
+		if name == "" && depth < len(folder_names) {
+			name = folder_names[depth]
+		}
+
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, utils.Category_separator)
 }
 
-func latex_note_title_to_txt(line string) string {
-	re := regexp.MustCompile(`\.*{.*} (.*[^\\])\\`)
-	match := re.FindStringSubmatch(line)
+var section_re = regexp.MustCompile(`^\s*\\((?:sub)*)section\*?\{(.*)\}\s*$`)
 
-	if len(match) == 0 {
-		log.Fatalf("Error processing line: %s", line)
+// add_section updates the section headings with the heading of the line, if any
+func add_section(sections []string, line string) ([]string, bool) {
+	match := section_re.FindStringSubmatch(line)
+	if match == nil {
+		return sections, false
 	}
 
-	return match[1]
+	depth := len(match[1]) / len("sub")
+
+	updated := make([]string, depth, depth+1)
+	copy(updated, sections)
+
+	return append(updated, strings.TrimSpace(escape_special_chars_to_markdown(latex_to_unicode(match[2], false)))), true
 }
 
-func latex_note_url_to_txt(line string) string {
-	line_net_content := latex_note_title_to_txt(line)
-	re := regexp.MustCompile(`\\url{(.*)}`)
-	return re.FindStringSubmatch(line_net_content)[1]
+func escape_special_chars_to_markdown(line string) string {
+	special_re := regexp.MustCompile(`\\(.)`)
+
+	return special_re.ReplaceAllString(line, "$1")
 }
 
 type LatexListener struct {
 	*latex_parser.BaseLatexListener
 
-	Title                  string
-	Url                    string
-	Created                string
-	Updated                string
+	Title   string
+	Url     string
+	Created string
+	Updated string
+	// values of the other metadata lines, as markdown, by key
+	Metadata               map[string]string
 	Note                   []string
 	block_stack            []string
 	word_stack             []string
 	text_stack             []string
 	verbatim_content_stack []string
-	is_verbatim_block      bool
+	// index of the text_stack where each open inline formatting command or link text starts, the innermost one last
+	tag_stack         []int
+	is_verbatim_block bool
+	// lists, list items and quotes being read, the innermost one last
+	environments []*latex_environment
+	// cells of each row of the table being read
+	table_rows [][]string
+	// texts of the footnotes, numbered from 1 in the order they are found
+	footnotes []string
+	// first error found while walking the parse tree, and the line of the note where it was found
+	err      error
+	err_line int
+	// lines of the note where the dates were found, to report dates that can not be parsed
+	created_line int
+	updated_line int
 }
 
-func (s *LatexListener) getTagValues(tag []antlr.Token) string {
-	values := make([]string, 0)
-	for _, tag := range tag {
-		values = append(values, tag.GetText())
+func (s *LatexListener) setError(err error, line int) {
+	if s.err == nil {
+		s.err = err
+		s.err_line = line
 	}
-
-	return strings.Join(values, "")
 }
 
 func (s *LatexListener) getWord() string {
@@ -73,28 +123,271 @@ func (s *LatexListener) getWord() string {
 	return word
 }
 
-// a line break and an empty line both mean a single empty line, even if combined or there are multiple empty lines
+// latex_environment is a list, list item or quote being read, along with the markdown lines read within it
+type latex_environment struct {
+	Kind string
+	// text of a list item up to its first nested list or quote, which goes on the line of the item marker
+	Text []string
+	// lines read within the environment, written to the enclosing one once it is closed. An empty line separates
+	// the paragraphs of a quote
+	Lines []string
+	// number of items of a list
+	Items int
+}
+
+// environment returns the innermost environment being read, or nil if there is none
+func (s *LatexListener) environment() *latex_environment {
+	if len(s.environments) == 0 {
+		return nil
+	}
+
+	return s.environments[len(s.environments)-1]
+}
+
+func (s *LatexListener) pushEnvironment(kind string) {
+	s.environments = append(s.environments, &latex_environment{Kind: kind})
+}
+
+func (s *LatexListener) popEnvironment() *latex_environment {
+	environment := s.environment()
+
+	s.environments = s.environments[:len(s.environments)-1]
+
+	return environment
+}
+
+// addLines adds markdown lines to the innermost environment, or to the note if there is none
+func (s *LatexListener) addLines(lines ...string) {
+	if environment := s.environment(); environment != nil {
+		environment.Lines = append(environment.Lines, lines...)
+	} else {
+		s.Note = append(s.Note, lines...)
+	}
+}
+
+// a line break and an empty line both mean a single empty line, even if combined or there are multiple empty lines.
+// Paragraphs of a list item are joined, as the item is written on a single line
 func (s *LatexListener) addParagraph() {
-	if s.is_verbatim_block == false && (s.Note == nil || s.Note[len(s.Note)-1] != "") {
+	if s.is_verbatim_block {
+		return
+	}
+
+	if environment := s.environment(); environment != nil {
+		if environment.Kind == "quote" && len(environment.Lines) > 0 && environment.Lines[len(environment.Lines)-1] != "" {
+			environment.Lines = append(environment.Lines, "")
+		}
+		return
+	}
+
+	if s.Note == nil || s.Note[len(s.Note)-1] != "" {
 		s.Note = append(s.Note, "")
 	}
 }
 
+// inline formatting commands, by the name of their token, and the markdown written for them
+var inline_formats = map[string][2]string{
+	`\textbf{`:    {"**", "**"},
+	`\emph{`:      {"*", "*"},
+	`\textit{`:    {"*", "*"},
+	`\texttt{`:    {"`", "`"},
+	`\underline{`: {"<u>", "</u>"},
+}
+
 func (s *LatexListener) EnterTag(ctx *latex_parser.TagContext) {
 	if s.word_stack != nil {
 		s.text_stack = append(s.text_stack, s.getWord())
 	}
+
+	s.tag_stack = append(s.tag_stack, len(s.text_stack))
 }
 
 func (s *LatexListener) ExitTag(ctx *latex_parser.TagContext) {
-	tagVal := s.getWord()
-	switch ctx.GetName().GetText() {
-	case "textbf":
-		s.text_stack = append(s.text_stack, fmt.Sprintf("**%s**", tagVal))
-	case "url":
-		s.text_stack = append(s.text_stack, fmt.Sprintf("[%s](%s)", tagVal, tagVal))
-	default:
-		log.Fatalf("Unknown tag: %s", ctx.GetText())
+	format := inline_formats[ctx.GetName().GetText()]
+
+	s.text_stack = append(s.text_stack, format[0]+s.popText()+format[1])
+}
+
+// popText returns the text read since the innermost open inline formatting command or link text started
+func (s *LatexListener) popText() string {
+	if s.word_stack != nil {
+		s.text_stack = append(s.text_stack, s.getWord())
+	}
+
+	start := s.tag_stack[len(s.tag_stack)-1]
+	s.tag_stack = s.tag_stack[:len(s.tag_stack)-1]
+
+	text := strings.Join(s.text_stack[start:], "")
+
+	s.text_stack = s.text_stack[:start]
+
+	return text
+}
+
+// VisitTerminal reads a newline within an inline formatting command, a link text, a footnote or a table cell as a
+// space, so the command is kept on a single markdown line
+func (s *LatexListener) VisitTerminal(node antlr.TerminalNode) {
+	parent, is_rule := node.GetParent().(antlr.RuleContext)
+
+	if is_rule && slices.Contains([]int{latex_parser.LatexParserRULE_tag, latex_parser.LatexParserRULE_href, latex_parser.LatexParserRULE_footnote, latex_parser.LatexParserRULE_table_cell}, parent.GetRuleIndex()) && node.GetSymbol().GetTokenType() == latex_parser.LatexParserNEWLINE {
+		s.word_stack = append(s.word_stack, " ")
+	}
+}
+
+func (s *LatexListener) EnterFootnote(ctx *latex_parser.FootnoteContext) {
+	if s.word_stack != nil {
+		s.text_stack = append(s.text_stack, s.getWord())
+	}
+
+	s.tag_stack = append(s.tag_stack, len(s.text_stack))
+}
+
+// ExitFootnote writes a numbered markdown footnote reference, keeping the footnote text to be defined at the end of
+// the note
+func (s *LatexListener) ExitFootnote(ctx *latex_parser.FootnoteContext) {
+	s.footnotes = append(s.footnotes, strings.TrimSpace(s.popText()))
+
+	s.text_stack = append(s.text_stack, fmt.Sprintf("[^%d]", len(s.footnotes)))
+}
+
+// ExitLatex defines the footnotes at the end of the note, after a blank line
+func (s *LatexListener) ExitLatex(ctx *latex_parser.LatexContext) {
+	// the paragraph break following a block that ends the note
+	for len(s.Note) > 0 && s.Note[len(s.Note)-1] == "" {
+		s.Note = s.Note[:len(s.Note)-1]
+	}
+
+	if len(s.footnotes) == 0 {
+		return
+	}
+
+	if len(s.Note) > 0 && s.Note[len(s.Note)-1] != "" {
+		s.Note = append(s.Note, "")
+	}
+
+	for index, text := range s.footnotes {
+		s.Note = append(s.Note, fmt.Sprintf("[^%d]: %s", index+1, text))
+	}
+}
+
+func (s *LatexListener) ExitCommand(ctx *latex_parser.CommandContext) {
+	s.getWord()
+	s.setError(fmt.Errorf("unknown command: %s", ctx.GetText()), ctx.GetStart().GetLine())
+}
+
+// url_token_to_txt returns the url of a \url token
+func url_token_to_txt(token antlr.TerminalNode) string {
+	return strings.TrimSuffix(strings.TrimPrefix(token.GetText(), `\url{`), "}")
+}
+
+func (s *LatexListener) EnterHref(ctx *latex_parser.HrefContext) {
+	if s.word_stack != nil {
+		s.text_stack = append(s.text_stack, s.getWord())
+	}
+
+	s.tag_stack = append(s.tag_stack, len(s.text_stack))
+}
+
+// ExitHref writes the link as markdown. The url may hold characters escaped for hyperref, e.g. \#, which are not
+// escaped in markdown
+func (s *LatexListener) ExitHref(ctx *latex_parser.HrefContext) {
+	text := s.popText()
+	url := strings.TrimSuffix(strings.TrimPrefix(ctx.HREF().GetText(), `\href{`), "}")
+
+	s.text_stack = append(s.text_stack, fmt.Sprintf("[%s](%s)", text, escape_special_chars_to_markdown(url)))
+}
+
+func (s *LatexListener) EnterUrl(ctx *latex_parser.UrlContext) {
+	if s.word_stack != nil {
+		s.text_stack = append(s.text_stack, s.getWord())
+	}
+}
+
+func (s *LatexListener) ExitUrl(ctx *latex_parser.UrlContext) {
+	url := url_token_to_txt(ctx.URL())
+
+	s.text_stack = append(s.text_stack, fmt.Sprintf("[%s](%s)", url, url))
+}
+
+// ExitInline_math writes the math as written, between $ markers
+func (s *LatexListener) ExitInline_math(ctx *latex_parser.Inline_mathContext) {
+	math := ctx.GetText()
+
+	if strings.HasPrefix(math, `\(`) {
+		math = strings.TrimSuffix(strings.TrimPrefix(math, `\(`), `\)`)
+	} else {
+		math = strings.TrimSuffix(strings.TrimPrefix(math, "$"), "$")
+	}
+
+	s.word_stack = append(s.word_stack, "$"+strings.TrimSpace(math)+"$")
+}
+
+// display math delimiters, the opening and closing one
+var display_math_delimiters = [][2]string{
+	{`$$`, `$$`},
+	{`\[`, `\]`},
+	{`\begin{equation}`, `\end{equation}`},
+	{`\begin{equation*}`, `\end{equation*}`},
+}
+
+// ExitDisplay_math writes the math between $$ markers. Math spanning several lines is written as lines between the
+// markers, unless within the text of a list item, which is written on a single line
+func (s *LatexListener) ExitDisplay_math(ctx *latex_parser.Display_mathContext) {
+	math := ctx.GetText()
+
+	for _, delimiter := range display_math_delimiters {
+		if strings.HasPrefix(math, delimiter[0]) && strings.HasSuffix(math, delimiter[1]) {
+			math = math[len(delimiter[0]) : len(math)-len(delimiter[1])]
+			break
+		}
+	}
+
+	lines := make([]string, 0)
+
+	for _, line := range strings.Split(math, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if environment := s.environment(); !strings.Contains(math, "\n") || environment != nil && environment.Kind == "item" && len(environment.Lines) == 0 {
+		s.word_stack = append(s.word_stack, "$$"+strings.Join(lines, " ")+"$$")
+		return
+	}
+
+	s.word_stack = append(s.word_stack, "$$\n"+strings.Join(lines, "\n")+"\n$$")
+}
+
+// metadata keys read by the grammar rules of their own, which every note header holds once
+var header_keys = []string{"Title", "URL", "Created", "Last Updated"}
+
+// metadata_key returns the key of a metadata line token, e.g. Author for \textbf{Author:}
+func metadata_key(token antlr.Token) string {
+	return strings.TrimSuffix(strings.TrimPrefix(token.GetText(), `\textbf{`), ":}")
+}
+
+// ExitHeader reports a metadata line either missing from the note header or found more than once
+func (s *LatexListener) ExitHeader(ctx *latex_parser.HeaderContext) {
+	keys := make([]string, 0, len(ctx.GetChildren()))
+
+	for _, child := range ctx.GetChildren() {
+		line, is_rule := child.(antlr.ParserRuleContext)
+		if !is_rule {
+			continue
+		}
+
+		key := metadata_key(line.GetStart())
+
+		if slices.Contains(keys, key) {
+			s.setError(fmt.Errorf("repeated metadata: %s", key), line.GetStart().GetLine())
+		}
+
+		keys = append(keys, key)
+	}
+
+	for _, key := range header_keys {
+		if !slices.Contains(keys, key) {
+			s.setError(fmt.Errorf("missing metadata: %s", key), ctx.GetStart().GetLine())
+		}
 	}
 }
 
@@ -102,34 +395,271 @@ func (s *LatexListener) ExitNote_title(ctx *latex_parser.Note_titleContext) {
 	s.Title = s.getWord()
 }
 
+func (s *LatexListener) EnterNote_metadata(ctx *latex_parser.Note_metadataContext) {
+	s.tag_stack = append(s.tag_stack, len(s.text_stack))
+}
+
+// ExitNote_metadata keeps the value of the metadata line, read as note text is. A value made of a \url alone is
+// the url itself
+func (s *LatexListener) ExitNote_metadata(ctx *latex_parser.Note_metadataContext) {
+	value := strings.TrimSpace(s.popText())
+
+	if urls := ctx.AllUrl(); len(urls) == 1 {
+		if url := url_token_to_txt(urls[0].URL()); value == fmt.Sprintf("[%s](%s)", url, url) {
+			value = url
+		}
+	}
+
+	if s.Metadata == nil {
+		s.Metadata = make(map[string]string)
+	}
+
+	s.Metadata[metadata_key(ctx.METADATA_KEY().GetSymbol())] = value
+}
+
 func (s *LatexListener) ExitNote_url(ctx *latex_parser.Note_urlContext) {
-	s.Url = s.getWord()
+	s.Url = url_token_to_txt(ctx.URL())
 }
 
 func (s *LatexListener) ExitNote_created(ctx *latex_parser.Note_createdContext) {
 	s.Created = s.getWord()
+	s.created_line = ctx.GetStart().GetLine()
 }
 
 func (s *LatexListener) ExitNote_updated(ctx *latex_parser.Note_updatedContext) {
 	s.Updated = s.getWord()
+	s.updated_line = ctx.GetStart().GetLine()
 }
 
+// ExitText adds a line of text. Within a list or quote, the text is written without its indentation, and the text of
+// a list item is kept apart up to its first nested list or quote. Text made of spaces only, e.g. the indentation of
+// an \item, is left out, and read as an empty line if it ends the line
 func (s *LatexListener) ExitText(ctx *latex_parser.TextContext) {
 	if s.word_stack != nil {
 		s.text_stack = append(s.text_stack, s.getWord())
 	}
-	s.Note = append(s.Note, strings.Join(s.text_stack, ""))
+
+	text := strings.Join(s.text_stack, "")
 
 	s.text_stack = nil
+
+	environment := s.environment()
+
+	switch {
+	case strings.TrimSpace(text) == "":
+		if ctx.NEWLINE() != nil {
+			s.addParagraph()
+		}
+	case environment == nil:
+		// display math is written on lines of its own
+		s.Note = append(s.Note, strings.Split(text, "\n")...)
+	case environment.Kind == "item" && len(environment.Lines) == 0:
+		environment.Text = append(environment.Text, strings.TrimSpace(text))
+	default:
+		for _, line := range strings.Split(text, "\n") {
+			environment.Lines = append(environment.Lines, strings.TrimSpace(line))
+		}
+	}
+}
+
+func (s *LatexListener) EnterItemize(ctx *latex_parser.ItemizeContext) {
+	s.pushEnvironment("itemize")
+}
+
+func (s *LatexListener) ExitItemize(ctx *latex_parser.ItemizeContext) {
+	s.addLines(s.popEnvironment().Lines...)
+	s.addParagraph()
+}
+
+func (s *LatexListener) EnterEnumerate(ctx *latex_parser.EnumerateContext) {
+	s.pushEnvironment("enumerate")
+}
+
+func (s *LatexListener) ExitEnumerate(ctx *latex_parser.EnumerateContext) {
+	s.addLines(s.popEnvironment().Lines...)
+	s.addParagraph()
+}
+
+func (s *LatexListener) EnterBlock_item(ctx *latex_parser.Block_itemContext) {
+	s.pushEnvironment("item")
+}
+
+// ExitBlock_item writes the item on the line of its marker, followed by its nested lists and quotes, which are
+// indented by 4 spaces per level
+func (s *LatexListener) ExitBlock_item(ctx *latex_parser.Block_itemContext) {
+	item := s.popEnvironment()
+	list := s.environment()
+
+	list.Items++
+
+	marker := "*"
+	if list.Kind == "enumerate" {
+		marker = fmt.Sprintf("%d.", list.Items)
+	}
+
+	list.Lines = append(list.Lines, strings.TrimSpace(marker+" "+strings.Join(item.Text, " ")))
+
+	for _, line := range item.Lines {
+		if line != "" {
+			line = "    " + line
+		}
+
+		list.Lines = append(list.Lines, line)
+	}
+}
+
+func (s *LatexListener) EnterQuote(ctx *latex_parser.QuoteContext) {
+	s.pushEnvironment("quote")
+}
+
+func (s *LatexListener) ExitQuote(ctx *latex_parser.QuoteContext) {
+	s.addQuote()
+}
+
+func (s *LatexListener) EnterQuotation(ctx *latex_parser.QuotationContext) {
+	s.pushEnvironment("quote")
+}
+
+func (s *LatexListener) ExitQuotation(ctx *latex_parser.QuotationContext) {
+	s.addQuote()
+}
+
+// addQuote writes the lines of the quote being closed starting with "> ", and its paragraph breaks as ">". A quote
+// nested in another one is a paragraph of it, and the text following a quote is a paragraph of its own, as otherwise it
+// would be read as part of the quote
+func (s *LatexListener) addQuote() {
+	quote := s.popEnvironment()
+
+	lines := make([]string, 0, len(quote.Lines))
+
+	for _, line := range quote.Lines {
+		lines = append(lines, strings.TrimRight("> "+line, " "))
+	}
+
+	// the paragraph break following the last paragraph
+	if len(lines) > 0 && lines[len(lines)-1] == ">" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if environment := s.environment(); environment != nil && environment.Kind == "quote" {
+		s.addParagraph()
+		s.addLines(lines...)
+		s.addParagraph()
+		return
+	}
+
+	s.addLines(lines...)
+	s.addParagraph()
+}
+
+// markdown table separator cells, by the alignment of the tabular column
+var table_alignments = map[byte]string{'l': "---", 'c': ":---:", 'r': "---:"}
+
+func (s *LatexListener) EnterTabular(ctx *latex_parser.TabularContext) {
+	s.table_rows = nil
+}
+
+func (s *LatexListener) EnterTable_row(ctx *latex_parser.Table_rowContext) {
+	s.table_rows = append(s.table_rows, nil)
+}
+
+func (s *LatexListener) EnterTable_cell(ctx *latex_parser.Table_cellContext) {
+	s.tag_stack = append(s.tag_stack, len(s.text_stack))
+}
+
+func (s *LatexListener) ExitTable_cell(ctx *latex_parser.Table_cellContext) {
+	row := len(s.table_rows) - 1
+
+	// a pipe would end the cell of the markdown table, so it is escaped, that of code once the code is restored
+	cell := strings.TrimSpace(s.popText())
+	cell = strings.NewReplacer("|", `\|`, fmt.Sprintf("ǂt%dǂ", '|'), `\|`).Replace(cell)
+	cell = code_placeholder_re.ReplaceAllString(cell, "ǂp${1}ǂ")
+
+	s.table_rows[row] = append(s.table_rows[row], cell)
+}
+
+// ExitTabular writes the table as a markdown table, whose first row is the header. Rows made of empty cells, e.g.
+// the one following the last \\, are left out
+func (s *LatexListener) ExitTabular(ctx *latex_parser.TabularContext) {
+	spec := strings.TrimSpace(strings.TrimPrefix(ctx.TABULAR().GetText(), `\begin{tabular}`))
+
+	// position argument, e.g. [t]
+	if strings.HasPrefix(spec, "[") {
+		spec = strings.TrimSpace(spec[strings.IndexByte(spec, ']')+1:])
+	}
+
+	spec, _, _ = command_argument(spec, 0)
+
+	alignments := tabular_alignments(spec)
+	columns := len(alignments)
+
+	rows := make([][]string, 0, len(s.table_rows))
+
+	for _, cells := range s.table_rows {
+		if strings.Join(cells, "") != "" {
+			columns = max(columns, len(cells))
+			rows = append(rows, cells)
+		}
+	}
+
+	s.table_rows = nil
+
+	if len(rows) == 0 {
+		s.setError(errors.New("table has no rows"), ctx.GetStart().GetLine())
+		return
+	}
+
+	separator := make([]string, 0, columns)
+
+	for index := 0; index < columns; index++ {
+		alignment := byte('l')
+		if index < len(alignments) {
+			alignment = alignments[index]
+		}
+
+		separator = append(separator, table_alignments[alignment])
+	}
+
+	row_line := func(cells []string) string {
+		for len(cells) < columns {
+			cells = append(cells, "")
+		}
+
+		return "| " + strings.Join(cells, " | ") + " |"
+	}
+
+	s.addLines(row_line(rows[0]), row_line(separator))
+
+	for _, cells := range rows[1:] {
+		s.addLines(row_line(cells))
+	}
 }
 
 func (s *LatexListener) ExitEscaped_word(ctx *latex_parser.Escaped_wordContext) {
-	content := ctx.GetContent().GetText()
-	space := ""
-	if ctx.GetSpace() != nil {
-		space = ctx.GetSpace().GetText()
+	// the content label only holds the last token of the escaped word, e.g. "case" for "\_case", so the whole
+	// text is used instead, without the leading backslash and the trailing newline
+	s.word_stack = append(s.word_stack, strings.TrimSuffix(strings.TrimPrefix(ctx.GetText(), `\`), "\n"))
+}
+
+// ExitDollar writes an escaped dollar sign, which is kept escaped in markdown so it is not read as math, except
+// within typewriter text, as markdown code is not escaped, and within the title, which is not markdown
+func (s *LatexListener) ExitDollar(ctx *latex_parser.DollarContext) {
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		tag, is_tag := parent.(*latex_parser.TagContext)
+		_, is_title := parent.(*latex_parser.Note_titleContext)
+
+		if is_title || is_tag && tag.GetName().GetText() == `\texttt{` {
+			s.word_stack = append(s.word_stack, "$")
+			return
+		}
 	}
-	s.word_stack = append(s.word_stack, content+space)
+
+	s.word_stack = append(s.word_stack, `\$`)
+}
+
+// ExitLabel writes bold text ending with a colon, read as a metadata key, as bold text
+func (s *LatexListener) ExitLabel(ctx *latex_parser.LabelContext) {
+	s.word_stack = append(s.word_stack, "**"+metadata_key(ctx.GetStart())+":**")
 }
 
 func (s *LatexListener) ExitLetter(ctx *latex_parser.LetterContext) {
@@ -156,26 +686,6 @@ func (s *LatexListener) ExitEmpty_line(ctx *latex_parser.Empty_lineContext) {
 	s.addParagraph()
 }
 
-func (s *LatexListener) ExitBlock_line(ctx *latex_parser.Block_lineContext) {
-	s.block_stack = append(s.block_stack, s.getWord())
-}
-
-func (s *LatexListener) ExitItemize(ctx *latex_parser.ItemizeContext) {
-	for _, item := range s.block_stack {
-		s.Note = append(s.Note, fmt.Sprintf("* %s", item))
-	}
-
-	s.block_stack = nil
-}
-
-func (s *LatexListener) ExitEnumerate(ctx *latex_parser.EnumerateContext) {
-	for i, item := range s.block_stack {
-		s.Note = append(s.Note, fmt.Sprintf("%d. %s", i+1, item))
-	}
-
-	s.block_stack = nil
-}
-
 func (s *LatexListener) ExitVerbatim_word(ctx *latex_parser.Verbatim_wordContext) {
 	s.verbatim_content_stack = append(s.verbatim_content_stack, s.getWord())
 }
@@ -195,6 +705,10 @@ func (s *LatexListener) ExitVerbatim_line(ctx *latex_parser.Verbatim_lineContext
 }
 
 func (s *LatexListener) EnterVerbatim(ctx *latex_parser.VerbatimContext) {
+	if s.environment() != nil {
+		s.setError(errors.New("code blocks are not supported in lists or quotes"), ctx.GetStart().GetLine())
+	}
+
 	s.is_verbatim_block = true
 }
 
@@ -212,95 +726,824 @@ func (s *LatexListener) ExitVerbatim(ctx *latex_parser.VerbatimContext) {
 	s.is_verbatim_block = false
 }
 
-func latex_to_note(latex_note []string) types.Note {
-	// Setup the input, replicating a text file (lines ending with newline)
-	is := antlr.NewInputStream(strings.Join(latex_note, "\n"))
+var metadata_re = regexp.MustCompile(`^\\textbf\{([^}]*):\}\s*(.*?)\s*\\\\\s*$`)
 
-	// Create the Lexer
-	lexer := latex_parser.NewLatexLexer(is)
+// closing_brace returns the index of the brace closing the one at open_index, or -1 if it is not closed on the line
+func closing_brace(line string, open_index int) int {
+	depth := 0
 
-	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	for index := open_index; index < len(line); index++ {
+		switch line[index] {
+		case '\\':
+			// escaped characters, e.g. \{, do not count
+			index++
+		case '{':
+			depth++
+		case '}':
+			depth--
 
-	// Create the Parser
+			if depth == 0 {
+				return index
+			}
+		}
+	}
+
+	return -1
+}
+
+// inline_formatter replaces the inline commands of a note that the latex grammar does not handle by placeholders
+// before parsing, and the placeholders by markdown once parsed
+type inline_formatter struct {
+	// image paths, which may hold characters the grammar does not accept, so they are kept out of the parsed text
+	urls []string
+	// inline code, as markdown, kept out of the parsed text for the same reason
+	code []string
+	// fence language of each code block, empty for verbatim blocks
+	languages []string
+	// lines of the code blocks, which may hold characters the grammar does not accept
+	code_lines []string
+	// depth of the braces within the \texttt argument being replaced, which may span lines, 0 outside of it
+	typewriter int
+}
+
+var url_placeholder_re = regexp.MustCompile(`ǂu(\d+)ǂ`)
+
+// latex inline math delimiters, along with the markdown delimiter they are written as
+var latex_math_delimiters = []struct {
+	Open     string
+	Close    string
+	Markdown string
+}{
+	// $$ goes before $, so it is not read as empty inline math
+	{`$$`, `$$`, `$$`},
+	{`$`, `$`, `$`},
+	{`\(`, `\)`, `$`},
+	{`\[`, `\]`, `$$`},
+}
+
+// math_close returns the index of the closing math delimiter, looking from index on, or -1 if it is not closed on the
+// line. Escaped characters, e.g. \$, do not close the math
+func math_close(line string, index int, close_delimiter string) int {
+	for ; index < len(line); index++ {
+		if strings.HasPrefix(line[index:], close_delimiter) {
+			return index
+		}
+
+		if line[index] == '\\' {
+			index++
+		}
+	}
+
+	return -1
+}
+
+// math_end returns the index following the math starting at index, or index if there is no math at index, or if it
+// is not closed on the line
+func math_end(line string, index int) int {
+	for _, delimiter := range latex_math_delimiters {
+		if !strings.HasPrefix(line[index:], delimiter.Open) {
+			continue
+		}
+
+		if close_index := math_close(line, index+len(delimiter.Open), delimiter.Close); close_index >= 0 {
+			return close_index + len(delimiter.Close)
+		}
+	}
+
+	return index
+}
+
+// command_argument returns the argument of the command starting at the given index, and the index following it.
+// The boolean return value is false if there is no argument, or if it is not closed on the line
+func command_argument(line string, index int) (string, int, bool) {
+	if index >= len(line) || line[index] != '{' {
+		return "", index, false
+	}
+
+	close_index := closing_brace(line, index)
+	if close_index < 0 {
+		return "", index, false
+	}
+
+	return line[index+1 : close_index], close_index + 1, true
+}
+
+// replaced_text is text whose inline commands are being replaced, along with the column of the original text, in
+// characters, each of its characters comes from, so syntax errors are reported where they are in the note
+type replaced_text struct {
+	strings.Builder
+	Columns []int
+}
+
+// write adds text replacing that of the original text starting at the given byte index
+func (r *replaced_text) write(text string, original string, index int) {
+	column := utf8.RuneCountInString(original[:index])
+
+	r.WriteString(text)
+
+	for range utf8.RuneCountInString(text) {
+		r.Columns = append(r.Columns, column)
+	}
+}
+
+// copy adds the original text between the given byte indexes as it is
+func (r *replaced_text) copy(original string, start int, end int) {
+	for index := start; index < end; {
+		_, size := utf8.DecodeRuneInString(original[index:])
+
+		r.write(original[index:index+size], original, index)
+		index += size
+	}
+}
+
+// replace replaces the inline commands of the line that the grammar does not read, e.g. accents, by placeholders
+func (f *inline_formatter) replace(line string) string {
+	return f.replace_text(line).String()
+}
+
+func (f *inline_formatter) replace_text(line string) *replaced_text {
+	replaced := &replaced_text{}
+	for index := 0; index < len(line); {
+		// math is read by the grammar as written
+		if next_index := math_end(line, index); next_index > index {
+			replaced.copy(line, index, next_index)
+			index = next_index
+			continue
+		}
+
+		// accented letters, e.g. \'e, and typographic characters, e.g. --, which are kept as written within typewriter
+		// text, as it is often code, e.g. --verbose
+		if text, next_index, found := latex_character(line, index); found {
+			if f.typewriter > 0 {
+				text = line[index:next_index]
+			}
+
+			replaced.write(character_placeholders(text), line, index)
+			index = next_index
+			continue
+		}
+
+		if line[index] != '\\' {
+			if f.typewriter > 0 && line[index] == '{' {
+				f.typewriter++
+			} else if f.typewriter > 0 && line[index] == '}' {
+				f.typewriter--
+			}
+
+			_, size := utf8.DecodeRuneInString(line[index:])
+
+			replaced.copy(line, index, index+size)
+			index += size
+			continue
+		}
+
+		name_end := index + 1
+		for name_end < len(line) && (line[name_end] >= 'a' && line[name_end] <= 'z' || line[name_end] >= 'A' && line[name_end] <= 'Z') {
+			name_end++
+		}
+
+		name := line[index+1 : name_end]
+
+		if name == "texttt" && f.typewriter == 0 && name_end < len(line) && line[name_end] == '{' {
+			f.typewriter = 1
+
+			replaced.copy(line, index, name_end+1)
+			index = name_end + 1
+			continue
+		} else if name == "verb" || name == "lstinline" {
+			if code, next_index, found := inline_code(line, index); found {
+				replaced.write(f.add_code(code), line, index)
+				index = next_index
+				continue
+			}
+		} else if name == "includegraphics" {
+			if image := includegraphics_re.FindStringSubmatchIndex(line[index:]); image != nil && image[0] == 0 {
+				replaced.write(f.add_image("", line[index+image[2]:index+image[3]]), line, index)
+				index += image[1]
+				continue
+			}
+		} else if name == "url" || name == "href" {
+			// the url, read by the grammar as written, may hold a $, which is not math, or a ~, which is not a space.
+			// The text of a link follows as any other text
+			if _, next_index, found := command_argument(line, name_end); found {
+				replaced.copy(line, index, next_index)
+				index = next_index
+				continue
+			}
+		}
+
+		// any other command, or an escaped character, is kept as is
+		name_end = max(name_end, min(index+2, len(line)))
+
+		replaced.copy(line, index, name_end)
+		index = name_end
+	}
+
+	return replaced
+}
+
+// replace_line replaces the inline commands of a line of text. A line ending with an escaped word, e.g. "100\%",
+// gets an end of line placeholder, as the grammar reads the newline following an escaped word as part of it.
+// It also returns the column of the line each character of the replaced line comes from, followed by the column
+// of the end of the line
+func (f *inline_formatter) replace_line(line string) (string, []int) {
+	replaced := f.replace_text(line)
+
+	if escaped_line_end_re.MatchString(replaced.String()) && !table_rule_line_end_re.MatchString(replaced.String()) {
+		replaced.write(line_end_placeholder, line, len(line))
+	}
+
+	return replaced.String(), append(replaced.Columns, utf8.RuneCountInString(line))
+}
+
+var escaped_line_end_re = regexp.MustCompile(`\\[\p{L}#%&_^<>]+[ \t]*$`)
+
+// table rules are read by the grammar as tokens of their own, which do not hold the newline
+var table_rule_line_end_re = regexp.MustCompile(`\\(hline|toprule|midrule|bottomrule)[ \t]*$`)
+
+// the dot ends the escaped word, as punctuation can not be part of it
+const line_end_placeholder = ".ǂeǂ"
+
+var math_block_begin_re = regexp.MustCompile(`^\s*(\\\[|\\begin\{(equation\*?)\})(.*)$`)
+
+// latex_block is a display math or figure block, which may span several lines
+type latex_block struct {
+	Kind  string
+	Close string
+	Lines []string
+	// line of the note where the block begins
+	Line int
+}
+
+// tabular_alignments returns the alignment, l, c or r, of each column of a tabular column specification. Paragraph
+// columns, e.g. p{3cm}, are left aligned, and vertical lines and inter column material are left out
+func tabular_alignments(spec string) []byte {
+	alignments := make([]byte, 0)
+
+	for index := 0; index < len(spec); index++ {
+		switch column := spec[index]; column {
+		case 'l', 'c', 'r':
+			alignments = append(alignments, column)
+		case 'p', 'm', 'b', 'X':
+			alignments = append(alignments, 'l')
+
+			if _, next_index, found := command_argument(spec, index+1); found {
+				index = next_index - 1
+			}
+		case '@', '!', '>', '<':
+			if _, next_index, found := command_argument(spec, index+1); found {
+				index = next_index - 1
+			}
+		case '*':
+			// *{count}{columns} repeats the columns
+			if count, columns_index, found := command_argument(spec, index+1); found {
+				if columns, next_index, found := command_argument(spec, columns_index); found {
+					repeat, _ := strconv.Atoi(strings.TrimSpace(count))
+
+					for ; repeat > 0; repeat-- {
+						alignments = append(alignments, tabular_alignments(columns)...)
+					}
+
+					index = next_index - 1
+				}
+			}
+		}
+	}
+
+	return alignments
+}
+
+// split_latex splits the text at each separator that is neither escaped nor within braces
+func split_latex(text string, separator string) []string {
+	parts := make([]string, 0)
+
+	depth := 0
+	start := 0
+
+	for index := 0; index < len(text); index++ {
+		if depth == 0 && strings.HasPrefix(text[index:], separator) {
+			parts = append(parts, text[start:index])
+			index += len(separator) - 1
+			start = index + 1
+			continue
+		}
+
+		switch text[index] {
+		case '\\':
+			index++
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+	}
+
+	return append(parts, text[start:])
+}
+
+// replace_note replaces the inline commands of the note text, leaving the metadata lines, verbatim blocks and display
+// math blocks spanning several lines, i.e. \[ and equation, as they are. Figures are replaced by a line of images. It
+// returns the replaced lines with the line of the note each of them comes from, and the columns of the note line each
+// of their characters comes from, nil for lines that are not replaced or come from a block
+func (f *inline_formatter) replace_note(lines []string, line_numbers []int) ([]string, []int, [][]int, *ParseError) {
+	replaced := make([]string, 0, len(lines))
+	replaced_numbers := make([]int, 0, len(lines))
+	replaced_columns := make([][]int, 0, len(lines))
+
+	add_line := func(line string, line_number int, columns []int) {
+		replaced = append(replaced, line)
+		replaced_numbers = append(replaced_numbers, line_number)
+		replaced_columns = append(replaced_columns, columns)
+	}
+
+	is_verbatim := false
+	is_code_line := false
+
+	var block *latex_block
+
+	for index, line := range lines {
+		line_number := line_numbers[index]
+
+		language, is_code := code_block_language(line)
+
+		if !is_verbatim && (is_code || strings.Contains(line, `\begin{verbatim}`)) {
+			// lstlisting and minted blocks are parsed as verbatim blocks, keeping their language apart
+			if is_code {
+				line = `\begin{verbatim}`
+			}
+
+			f.languages = append(f.languages, language)
+			is_verbatim = true
+		}
+
+		if is_verbatim {
+			if is_verbatim && code_block_end_re.MatchString(line) {
+				line = code_block_end_re.ReplaceAllString(line, `\end{verbatim}`)
+				is_verbatim = false
+			} else if is_verbatim && is_code_line && strings.TrimSpace(line) != "" {
+				line = f.add_code_line(line)
+			}
+
+			add_line(line, line_number, nil)
+
+			// the lines following the beginning of the block are its code
+			is_code_line = is_verbatim
+			continue
+		}
+
+		if block == nil {
+			// \[ closed on the same line is inline math
+			if begin := math_block_begin_re.FindStringSubmatch(line); begin != nil && !(begin[2] == "" && strings.Contains(begin[3], `\]`)) {
+				block = &latex_block{Kind: "math", Close: `\]`, Line: line_number}
+
+				if begin[2] != "" {
+					block.Close = `\end{` + begin[2] + `}`
+				}
+
+				line = begin[3]
+			} else if begin := figure_begin_re.FindStringSubmatchIndex(line); begin != nil {
+				block = &latex_block{Kind: "figure", Close: `\end{` + line[begin[2]:begin[3]] + `}`, Line: line_number}
+				line = line[begin[1]:]
+			}
+		}
+
+		if block == nil {
+			text, columns := f.replace_line(line)
+
+			add_line(text, line_number, columns)
+			continue
+		}
+
+		close_index := strings.Index(line, block.Close)
+
+		if block.Kind == "math" {
+			// the math is read by the grammar as written, only the text following it is replaced
+			offset := len(lines[index]) - len(line)
+			line = lines[index]
+
+			if close_index < 0 {
+				add_line(line, line_number, nil)
+				continue
+			}
+
+			close_index += offset + len(block.Close)
+			block = nil
+
+			text, columns := f.replace_line(line[close_index:])
+
+			math_columns := make([]int, 0, len(columns))
+			for column := range utf8.RuneCountInString(line[:close_index]) {
+				math_columns = append(math_columns, column)
+			}
+
+			for _, column := range columns {
+				math_columns = append(math_columns, column+utf8.RuneCountInString(line[:close_index]))
+			}
+
+			add_line(line[:close_index]+text, line_number, math_columns)
+			continue
+		}
+
+		if close_index < 0 {
+			block.Lines = append(block.Lines, line)
+			continue
+		}
+
+		block.Lines = append(block.Lines, line[:close_index])
+
+		images, err := f.replace_figure(strings.Join(block.Lines, " "))
+		if err != nil {
+			return replaced, replaced_numbers, replaced_columns, &ParseError{Line: block.Line, Err: err}
+		}
+
+		add_line(images, block.Line, nil)
+
+		// any text following the block goes on, its columns following those of the block
+		text_index := len(line) - len(strings.TrimLeft(line[close_index+len(block.Close):], " \t"))
+		block = nil
+
+		if strings.TrimSpace(line[text_index:]) != "" {
+			text, columns := f.replace_line(line[text_index:])
+
+			for index := range columns {
+				columns[index] += utf8.RuneCountInString(line[:text_index])
+			}
+
+			add_line(text, line_number, columns)
+		}
+	}
+
+	if block != nil {
+		return replaced, replaced_numbers, replaced_columns, &ParseError{Line: block.Line, Err: fmt.Errorf("%s block is not closed", block.Kind)}
+	}
+
+	return replaced, replaced_numbers, replaced_columns, nil
+}
+
+// restore replaces the placeholders of a parsed line by their markdown
+func (f *inline_formatter) restore(line string) string {
+	if match := code_line_placeholder_re.FindStringSubmatch(line); match != nil {
+		index, _ := strconv.Atoi(match[1])
+
+		if index < len(f.code_lines) {
+			return f.code_lines[index]
+		}
+	}
+
+	// the text of a list item may be joined from several lines
+	line = strings.ReplaceAll(line, line_end_placeholder, "")
+
+	line = url_placeholder_re.ReplaceAllStringFunc(line, func(placeholder string) string {
+		index, _ := strconv.Atoi(url_placeholder_re.FindStringSubmatch(placeholder)[1])
+
+		if index < len(f.urls) {
+			return f.urls[index]
+		}
+
+		return placeholder
+	})
+
+	line = restore_characters(line)
+
+	// code goes last, so its markdown is not replaced
+
+	line = code_placeholder_re.ReplaceAllStringFunc(line, func(placeholder string) string {
+		index, _ := strconv.Atoi(code_placeholder_re.FindStringSubmatch(placeholder)[1])
+
+		if index < len(f.code) {
+			return f.code[index]
+		}
+
+		return placeholder
+	})
+
+	line = table_code_placeholder_re.ReplaceAllStringFunc(line, func(placeholder string) string {
+		index, _ := strconv.Atoi(table_code_placeholder_re.FindStringSubmatch(placeholder)[1])
+
+		if index < len(f.code) {
+			return strings.ReplaceAll(f.code[index], "|", `\|`)
+		}
+
+		return placeholder
+	})
+
+	return line
+}
+
+// latex_to_note parses a single note. On error, the returned ParseError line is relative to the start of the note.
+// Syntax errors are all returned, as ParseErrors
+func latex_to_note(latex_note []string) (types.Note, error) {
+	lines := latex_note
+	line_numbers := make([]int, len(latex_note))
+
+	for index := range latex_note {
+		line_numbers[index] = index + 1
+	}
+
+	// maps a line of the parsed text to the line of the note
+	note_line := func(line int) int {
+		if line >= 1 && line <= len(line_numbers) {
+			return line_numbers[line-1]
+		}
+		return line
+	}
+
+	var formatter inline_formatter
+
+	lines, line_numbers, columns, block_err := formatter.replace_note(lines, line_numbers)
+
+	// maps a column of a line of the parsed text, starting at 0, to the column of the line of the note
+	note_column := func(line int, column int) int {
+		if line >= 1 && line <= len(columns) && column >= 0 && column < len(columns[line-1]) {
+			return columns[line-1][column]
+		}
+		return column
+	}
+
+	// Setup the input, replicating a text file (lines ending with newline)
+	is := antlr.NewInputStream(strings.Join(lines, "\n"))
+
+	// Create the Lexer
+	lexer := latex_parser.NewLatexLexer(is)
+
+	// record the syntax errors instead of printing them
+	syntax_errors := &syntax_error_listener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(syntax_errors)
+
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+	// Create the Parser
 	p := latex_parser.NewLatexParser(stream)
 
+	p.RemoveErrorListeners()
+	p.AddErrorListener(syntax_errors)
+
 	var listener LatexListener
 
 	// Finally parse the expression
 	antlr.ParseTreeWalkerDefault.Walk(&listener, p.Latex())
 
-	return types.Note{
-		listener.Title,
-		listener.Url,
-		listener.Created,
-		listener.Updated,
-		listener.Note,
+	listener.Title = restore_characters(listener.Title)
+
+	if block_err != nil {
+		// the blocks are read before parsing, so the note title is only known now
+		block_err.Title = listener.Title
+		return types.Note{}, block_err
 	}
-}
 
-func process_latex_file(file_path string) []types.Note {
-	fmt.Println("Processing " + file_path)
-	f, err := os.Open(file_path)
+	if len(syntax_errors.errors) > 0 {
+		parse_errs := make(ParseErrors, 0, len(syntax_errors.errors))
+
+		for _, syntax_err := range syntax_errors.errors {
+			parse_errs = append(parse_errs, &ParseError{Title: listener.Title, Line: note_line(syntax_err.Line), Column: note_column(syntax_err.Line, syntax_err.Column) + 1, Err: syntax_err.Err})
+		}
+
+		return types.Note{}, parse_errs
+	}
+
+	if listener.err != nil {
+		return types.Note{}, &ParseError{Title: listener.Title, Line: note_line(listener.err_line), Err: listener.err}
+	}
 
+	created, err := utils.Parse_date(listener.Created)
 	if err != nil {
-		log.Fatalf("Error opening file %s: %v", file_path, err)
+		return types.Note{}, &ParseError{Title: listener.Title, Line: note_line(listener.created_line), Err: fmt.Errorf("invalid created date: %w", err)}
 	}
 
-	defer f.Close()
+	updated, err := utils.Parse_date(listener.Updated)
+	if err != nil {
+		return types.Note{}, &ParseError{Title: listener.Title, Line: note_line(listener.updated_line), Err: fmt.Errorf("invalid last updated date: %w", err)}
+	}
+
+	var text []string
+
+	// code block fences, each block opening with the fence holding its language
+	fences := 0
+
+	for _, line := range listener.Note {
+		if line == "```" {
+			if fences%2 == 0 && fences/2 < len(formatter.languages) {
+				line += formatter.languages[fences/2]
+			}
+
+			fences++
+			text = append(text, line)
+			continue
+		}
+
+		text = append(text, formatter.restore(line))
+	}
 
-	scanner := bufio.NewScanner(f)
+	var tags []string
+	var note_metadata map[string]string
+
+	for key, value := range listener.Metadata {
+		if key == "Tags" {
+			tags = utils.Normalise_tags(strings.Split(formatter.restore(value), ","))
+			continue
+		}
+
+		if note_metadata == nil {
+			note_metadata = make(map[string]string)
+		}
+
+		note_metadata[restore_characters(escape_special_chars_to_markdown(key))] = formatter.restore(value)
+	}
+
+	return types.Note{
+		Title:        listener.Title,
+		Url:          listener.Url,
+		Created_date: created,
+		Updated_date: updated,
+		Text:         text,
+		Tags:         tags,
+		Metadata:     note_metadata,
+	}, nil
+}
+
+// process_latex_file parses the notes of the lines read from a latex file, along with the section headings
+// preceding each of them. Unless strict, a note that can not be parsed is skipped and parsing goes on with the next
+// one, returning the errors of every skipped note as ParseErrors
+func process_latex_file(file_path string, lines []source_line, strict bool) (FileNotes, error) {
+	fmt.Println("Processing " + file_path)
+
+	file_notes := FileNotes{File_path: file_path, Notes: make([]types.Note, 0), Sections: make([][]string, 0)}
 
 	is_note := false
 	cur_note := make([]string, 0, 20)
-	notes := make([]types.Note, 0)
+	// lines of the files the lines of the current note were read from
+	cur_note_sources := make([]source_line, 0, 20)
+
+	var sections []string
+	var skipped ParseErrors
+
+	// lines of the files the notes were read from, as written, to record where each note comes from
+	file_lines := make(map[string][]string)
 
-	for scanner.Scan() {
-		line := scanner.Text()
+	for _, source := range lines {
+		line := source.Text
 
-		if !is_note && strings.HasPrefix(line, "\\textbf{Title:}") {
+		if !is_note {
+			var is_section bool
+			if sections, is_section = add_section(sections, line); is_section {
+				// category paths are split on the separator, so a heading holding it would be read as several categories
+				if heading := sections[len(sections)-1]; strings.Contains(heading, utils.Category_separator) {
+					return file_notes, &ParseError{File_path: source.File_path, Line: source.Line, Err: fmt.Errorf("section heading %q can not hold the category separator %s", heading, utils.Category_separator)}
+				}
+
+				continue
+			}
+		}
+
+		// a note starts with its header, whose first line may be any metadata line
+		if !is_note && metadata_re.MatchString(line) {
 			is_note = true
 		}
 		if is_note {
 			if strings.HasPrefix(line, "\\hrulefill") {
-				notes = append(notes, latex_to_note(cur_note))
+				note, err := latex_to_note(cur_note)
+
+				if err == nil {
+					// images are read from the folder of the file being imported, as latex does when compiling it
+					if image_err := read_note_images(&note, filepath.Dir(file_path), cur_note); image_err != nil {
+						err = image_err
+					}
+				}
+
+				note_sources := cur_note_sources
 
 				cur_note = nil
+				cur_note_sources = nil
 				is_note = false
+
+				if err != nil {
+					parse_errs, ok := parse_errors(err)
+					if !ok {
+						return file_notes, err
+					}
+
+					// the error lines are relative to the start of the note, whose lines may come from several files
+					for _, parse_err := range parse_errs {
+						if parse_err.Line >= 1 && parse_err.Line <= len(note_sources) {
+							parse_err.File_path = note_sources[parse_err.Line-1].File_path
+							parse_err.Line = note_sources[parse_err.Line-1].Line
+						} else {
+							parse_err.File_path = source.File_path
+							parse_err.Line = source.Line
+						}
+					}
+
+					if strict {
+						return file_notes, err
+					}
+
+					skipped = append(skipped, parse_errs...)
+					continue
+				}
+
+				if note.Provenance, err = note_provenance(note_sources, source, file_lines); err != nil {
+					return file_notes, err
+				}
+
+				file_notes.Notes = append(file_notes.Notes, note)
+				file_notes.Sections = append(file_notes.Sections, sections)
 				continue
 			}
 			cur_note = append(cur_note, line)
+			cur_note_sources = append(cur_note_sources, source)
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatalf("Error reading %s: %v", file_path, err)
+	if len(skipped) > 0 {
+		return file_notes, skipped
 	}
 
-	return notes
+	return file_notes, nil
 }
 
-func Process_files(folder_path string, extension string) []FileNotes {
-	files, err := os.ReadDir(folder_path)
+// Process_files parses the notes of every file with the given extension under folder_path. Files included by another
+// file, with \input or \include, are only parsed as part of the including file. If strict, parsing stops at the
+// first note that can not be parsed, returning its ParseError. Otherwise the notes that can not be parsed are
+// skipped, returning the notes of every file along with a ParseErrors holding the errors of the skipped notes
+func Process_files(folder_path string, extension string, strict bool) ([]FileNotes, error) {
+	file_notes := make([]FileNotes, 0)
+
+	if extension != "tex" {
+		return file_notes, nil
+	}
+
+	file_paths := make([]string, 0)
+
+	err := filepath.WalkDir(folder_path, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.IsDir() && filepath.Ext(path) == "."+extension {
+			file_paths = append(file_paths, path)
+		}
+
+		return nil
+	})
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	file_notes := make([]FileNotes, 0)
+	var skipped ParseErrors
 
-	for _, file := range files {
-		if file.IsDir() {
-			file_notes = append(file_notes, Process_files(filepath.Join(folder_path, file.Name()), extension)...)
-		} else {
-			switch extension {
-			case "tex":
-				file_path := filepath.Join(folder_path, file.Name())
-				if filepath.Ext(file_path) == "."+extension {
-					file_notes = append(file_notes, FileNotes{file_path, process_latex_file(file_path)})
-				}
-				break
-			}
+	// keep_going collects the errors of the skipped notes, returning false if processing must stop
+	keep_going := func(err error) bool {
+		if err == nil {
+			return true
+		}
+
+		if strict {
+			return false
+		}
+
+		parse_errs, ok := parse_errors(err)
+		if !ok {
+			return false
 		}
+
+		skipped = append(skipped, parse_errs...)
+		return true
+	}
+
+	reader := new_latex_reader()
+
+	// every file is read before parsing any, to know which files are included by another one
+	file_lines := make([][]source_line, len(file_paths))
+
+	for index, file_path := range file_paths {
+		lines, err := reader.read(file_path, nil)
+
+		if !keep_going(err) {
+			return file_notes, err
+		}
+
+		file_lines[index] = lines
+	}
+
+	for index, file_path := range file_paths {
+		if file_lines[index] == nil || reader.is_included(file_path) {
+			continue
+		}
+
+		notes, err := process_latex_file(file_path, file_lines[index], strict)
+
+		if !keep_going(err) {
+			return file_notes, err
+		}
+
+		file_notes = append(file_notes, notes)
+	}
+
+	if len(skipped) > 0 {
+		return file_notes, skipped
 	}
 
-	return file_notes
+	return file_notes, nil
 }
@@ -0,0 +1,222 @@
+package parser
+
+import (
+	"bufio"
+	"cotonetes/types"
+	"cotonetes/utils"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// source_line is a line of latex along with the file, and line of that file, it was read from
+type source_line struct {
+	File_path string
+	Line      int
+	Text      string
+}
+
+var include_re = regexp.MustCompile(`\\(?:input|include)\{([^}]*)\}`)
+
+// strip_comment removes the comment of a latex line, i.e. the text following a % not escaped by a backslash,
+// along with the spaces preceding it. Urls and inline code may hold a %, so the argument of \url, and the code of \verb
+// and \lstinline, are left as they are. It returns whether a comment was found
+func strip_comment(line string) (string, bool) {
+	for index := 0; index < len(line); index++ {
+		// inline code may hold a %
+		if _, next_index, found := inline_code(line, index); found {
+			index = next_index - 1
+			continue
+		}
+
+		if strings.HasPrefix(line[index:], `\url{`) {
+			if close_index := strings.IndexByte(line[index:], '}'); close_index >= 0 {
+				index += close_index
+				continue
+			}
+		}
+
+		switch line[index] {
+		case '\\':
+			// the escaped char, which may be a % or another backslash, can not start a comment
+			index++
+		case '%':
+			return strings.TrimRight(line[:index], " \t"), true
+		}
+	}
+
+	return line, false
+}
+
+// include_path returns the path of a file included from file_path, which is relative to the including file.
+// As latex does, the .tex extension may be left out
+func include_path(file_path string, included string) string {
+	included = strings.TrimSpace(included)
+
+	if filepath.Ext(included) == "" {
+		included += ".tex"
+	}
+
+	if !filepath.IsAbs(included) {
+		included = filepath.Join(filepath.Dir(file_path), included)
+	}
+
+	return included
+}
+
+// latex_reader reads latex files, dropping their comments and replacing \input and \include commands by the
+// lines of the included file
+type latex_reader struct {
+	// absolute paths of the files included by another file
+	included map[string]bool
+}
+
+func new_latex_reader() *latex_reader {
+	return &latex_reader{included: make(map[string]bool)}
+}
+
+// read returns the lines of a latex file, including is the chain of files including it, as absolute paths.
+// Lines holding only a comment are dropped, as latex does not read them as an empty line. Verbatim, lstlisting and
+// minted blocks are kept as they are
+func (r *latex_reader) read(file_path string, including []string) ([]source_line, error) {
+	f, err := os.Open(file_path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file %s: %w", file_path, err)
+	}
+
+	defer f.Close()
+
+	absolute_path, err := filepath.Abs(file_path)
+	if err != nil {
+		return nil, err
+	}
+
+	including = append(including[:len(including):len(including)], absolute_path)
+
+	scanner := bufio.NewScanner(f)
+
+	lines := make([]source_line, 0)
+	line_number := 0
+	is_code := false
+
+	add_line := func(text string) {
+		lines = append(lines, source_line{file_path, line_number, text})
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		line_number++
+
+		if is_code || code_block_begin_re.MatchString(line) {
+			add_line(line)
+
+			is_code = !code_block_end_re.MatchString(line)
+			continue
+		}
+
+		text, is_comment := strip_comment(line)
+
+		if is_comment && strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		position := 0
+
+		for _, include := range include_re.FindAllStringSubmatchIndex(text, -1) {
+			if before := text[position:include[0]]; strings.TrimSpace(before) != "" {
+				add_line(before)
+			}
+
+			position = include[1]
+
+			included_path := include_path(file_path, text[include[2]:include[3]])
+
+			absolute_included_path, err := filepath.Abs(included_path)
+			if err != nil {
+				return nil, err
+			}
+
+			if index := slices.Index(including, absolute_included_path); index >= 0 {
+				cycle := append(including[index:len(including):len(including)], absolute_included_path)
+				return nil, &ParseError{File_path: file_path, Line: line_number, Err: fmt.Errorf("include cycle: %s", strings.Join(cycle, " -> "))}
+			}
+
+			r.included[absolute_included_path] = true
+
+			included_lines, err := r.read(included_path, including)
+			if err != nil {
+				if _, ok := parse_errors(err); ok {
+					return nil, err
+				}
+
+				return nil, &ParseError{File_path: file_path, Line: line_number, Err: err}
+			}
+
+			lines = append(lines, included_lines...)
+		}
+
+		if position == 0 {
+			add_line(text)
+		} else if after := text[position:]; strings.TrimSpace(after) != "" {
+			add_line(after)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", file_path, err)
+	}
+
+	return lines, nil
+}
+
+// is_included returns whether the file is included by another file read so far
+func (r *latex_reader) is_included(file_path string) bool {
+	absolute_path, err := filepath.Abs(file_path)
+
+	return err == nil && r.included[absolute_path]
+}
+
+// note_provenance returns where a note was read from: the file holding its header, and the lines of that file from
+// the header to the \hrulefill line ending the note, end. The lines of each file are only read once, into file_lines
+func note_provenance(note_sources []source_line, end source_line, file_lines map[string][]string) (types.Provenance, error) {
+	file_path := note_sources[0].File_path
+
+	provenance := types.Provenance{Start_line: note_sources[0].Line, End_line: note_sources[0].Line}
+
+	// lines included from other files are left out, as is an \hrulefill in another file
+	for _, source := range append(note_sources[1:len(note_sources):len(note_sources)], end) {
+		if source.File_path == file_path {
+			provenance.End_line = max(provenance.End_line, source.Line)
+		}
+	}
+
+	lines, found := file_lines[file_path]
+
+	if !found {
+		var err error
+
+		if lines, err = utils.Read_lines(file_path); err != nil {
+			return provenance, fmt.Errorf("error reading %s: %w", file_path, err)
+		}
+
+		file_lines[file_path] = lines
+	}
+
+	if provenance.End_line > len(lines) {
+		return provenance, fmt.Errorf("%s changed while being read", file_path)
+	}
+
+	provenance.Hash = utils.Source_hash(lines[provenance.Start_line-1 : provenance.End_line])
+
+	absolute_path, err := filepath.Abs(file_path)
+	if err != nil {
+		return provenance, err
+	}
+
+	provenance.File_path = absolute_path
+
+	return provenance, nil
+}
@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"cotonetes/utils"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeLatexFile(t *testing.T, file_path string, lines []string) {
+	if err := os.MkdirAll(filepath.Dir(file_path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(file_path, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStripComment(t *testing.T) {
+	for line, expected := range map[string]string{
+		`some text % a comment`:              `some text`,
+		`100\% sure`:                         `100\% sure`,
+		`line break\\% a comment`:            `line break\\`,
+		`\url{https://example.com/a%20b} %x`: `\url{https://example.com/a%20b}`,
+		`% only a comment`:                   ``,
+	} {
+		obtained, _ := strip_comment(line)
+		utils.FailNotEquals(t, "Failed to strip comment of "+line, expected, obtained)
+	}
+}
+
+func TestInputFiles(t *testing.T) {
+	folder_path := t.TempDir()
+
+	note := utils.NoteToLatex(utils.TdTextOnly.Latex)
+	// replace the note text by an \input of a file holding it
+	note = append(note[:5:5], append([]string{`% the text is kept apart`, `\input{parts/text}`}, note[6:]...)...)
+
+	writeLatexFile(t, folder_path+"/notes.tex", append(note, `\include{parts/other} % another note`))
+	writeLatexFile(t, folder_path+"/parts/text.tex", []string{`Sample line % comment`})
+	writeLatexFile(t, folder_path+"/parts/other.tex", utils.NoteToLatex(utils.TdNoteBoldText.Latex))
+
+	processed_notes, err := Process_files(folder_path, "tex", true)
+
+	utils.FailNotEquals(t, "Failed to process files", nil, err)
+	utils.FailNotEquals(t, "Failed to skip included files", 1, len(processed_notes))
+	utils.FailNotEquals(t, "Failed to process expected number of notes", 2, len(processed_notes[0].Notes))
+	utils.FailNotEqualsSlice(t, "Failed to include note text", []string{"", "Sample line"}, processed_notes[0].Notes[0].Text)
+	utils.FailNotEquals(t, "Failed to keep note source file", folder_path+"/notes.tex", processed_notes[0].Notes[0].Provenance.File_path)
+	utils.FailNotEquals(t, "Failed to keep included note source file", folder_path+"/parts/other.tex", processed_notes[0].Notes[1].Provenance.File_path)
+}
+
+func TestNoteProvenance(t *testing.T) {
+	folder_path := t.TempDir()
+
+	first := utils.NoteToLatex(utils.TdTextOnly.Latex)
+	second := utils.NoteToLatex(utils.TdNoteBoldText.Latex)
+	// the text of the second note is in another file
+	second = append(second[:5:5], append([]string{`\input{parts/text}`}, second[6:]...)...)
+
+	lines := append(append([]string{`\section{notes}`, ""}, first...), second...)
+
+	writeLatexFile(t, folder_path+"/notes.tex", lines)
+	writeLatexFile(t, folder_path+"/parts/text.tex", []string{utils.TdNoteBoldText.Latex.Text[0]})
+
+	processed_notes, err := Process_files(folder_path, "tex", true)
+
+	utils.FailNotEquals(t, "Failed to process files", nil, err)
+	utils.FailNotEquals(t, "Failed to process expected number of notes", 2, len(processed_notes[0].Notes))
+
+	for index, expected := range [][2]int{{3, 9}, {12, 18}} {
+		provenance := processed_notes[0].Notes[index].Provenance
+
+		utils.FailNotEquals(t, "Failed to record note file", folder_path+"/notes.tex", provenance.File_path)
+		utils.FailNotEquals(t, "Failed to record note start line", expected[0], provenance.Start_line)
+		utils.FailNotEquals(t, "Failed to record note end line", expected[1], provenance.End_line)
+		utils.FailNotEquals(t, "Failed to record note hash", utils.Source_hash(lines[expected[0]-1:expected[1]]), provenance.Hash)
+	}
+}
+
+func TestIncludedFileError(t *testing.T) {
+	folder_path := t.TempDir()
+
+	note := utils.TdTextOnly.Latex
+	note.Text = []string{`\input{text}`}
+
+	writeLatexFile(t, folder_path+"/notes.tex", utils.NoteToLatex(note))
+	writeLatexFile(t, folder_path+"/text.tex", []string{`first line`, `second line with a_b`})
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report included file path", folder_path+"/text.tex", parse_err.File_path)
+	utils.FailNotEquals(t, "Failed to report included file line number", 2, parse_err.Line)
+	utils.FailNotEquals(t, "Failed to report note title", note.Title, parse_err.Title)
+}
+
+func TestIncludeCycleError(t *testing.T) {
+	folder_path := t.TempDir()
+
+	writeLatexFile(t, folder_path+"/a.tex", []string{`\input{b}`})
+	writeLatexFile(t, folder_path+"/b.tex", []string{`first line`, `\include{a.tex}`})
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report file path", folder_path+"/b.tex", parse_err.File_path)
+	utils.FailNotEquals(t, "Failed to report line number", 2, parse_err.Line)
+	utils.FailNotEquals(t, "Failed to report include cycle", true, strings.Contains(parse_err.Error(), "include cycle"))
+}
@@ -3,8 +3,11 @@ package parser
 import (
 	"cotonetes/types"
 	"cotonetes/utils"
+	"errors"
 	"log"
+	"maps"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -34,7 +37,9 @@ func setupTest(t *testing.T, note types.Note) (string, string) {
 }
 
 func LatexParserTest(t *testing.T, folder_path string, expected_markdown types.Note) {
-	processed_notes := Process_files(folder_path, "tex")
+	processed_notes, err := Process_files(folder_path, "tex", true)
+
+	utils.FailNotEquals(t, "Failed to process files", nil, err)
 
 	utils.FailNotEquals(t, "Failed to process expected number of files", 1, len(processed_notes))
 
@@ -46,6 +51,9 @@ func LatexParserTest(t *testing.T, folder_path string, expected_markdown types.N
 	utils.FailNotEquals(t, "Failed to process note url", expected_markdown.Url, processed_note.Url)
 	utils.FailNotEquals(t, "Failed to process note created date", expected_markdown.Created_date, processed_note.Created_date)
 	utils.FailNotEquals(t, "Failed to process note updated date", expected_markdown.Updated_date, processed_note.Updated_date)
+	utils.FailNotEqualsStruct(t, "Failed to process note tags", expected_markdown.Tags, processed_note.Tags)
+	utils.FailNotEquals(t, "Failed to process note images", true, utils.Images_equal(expected_markdown.Images, processed_note.Images))
+	utils.FailNotEqualsStruct(t, "Failed to process note metadata", expected_markdown.Metadata, processed_note.Metadata)
 
 	emptyline, note_content := processed_note.Text[0], processed_note.Text[1:]
 
@@ -76,14 +84,81 @@ func TestNoteBoldText(t *testing.T) {
 	baseLatexParserTest(t, utils.TdNoteBoldText)
 }
 
+func TestNoteInlineFormatting(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteInlineFormatting)
+}
+
+func TestNoteTextit(t *testing.T) {
+	note := utils.TdTextOnly.Latex
+	note.Text = []string{`\textit{italic} and snake\_case`}
+
+	expected := utils.TdTextOnly.Markdown
+	expected.Text = []string{"*italic* and snake_case"}
+
+	folder_path, _ := setupTest(t, note)
+
+	LatexParserTest(t, folder_path, expected)
+}
+
+func TestNoteMultilineFormatting(t *testing.T) {
+	note := utils.TdTextOnly.Latex
+	note.Text = []string{`some \textbf{bold text with \emph{emphasis`, `spanning} two lines} and \texttt{code}`}
+
+	expected := utils.TdTextOnly.Markdown
+	expected.Text = []string{"some **bold text with *emphasis spanning* two lines** and `code`"}
+
+	folder_path, _ := setupTest(t, note)
+
+	LatexParserTest(t, folder_path, expected)
+}
+
 func TestNoteUrl(t *testing.T) {
 	baseLatexParserTest(t, utils.TdNoteUrl)
 }
 
+func TestNoteUrlSpecialChars(t *testing.T) {
+	note := utils.TdNoteUrl.Latex
+	note.Url = "https://example.com/page?utm_source=feed&utm_medium=rss%20x#top~1"
+	note.Text = []string{`see \url{https://example.com/some_page?a=1&b=2%20c#part} for test`}
+
+	expected := utils.TdNoteUrl.Markdown
+	expected.Url = note.Url
+	expected.Text = []string{"see [https://example.com/some_page?a=1&b=2%20c#part](https://example.com/some_page?a=1&b=2%20c#part) for test"}
+
+	folder_path, _ := setupTest(t, note)
+
+	LatexParserTest(t, folder_path, expected)
+}
+
+func TestNoteHref(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteHref)
+}
+
+func TestNoteHrefSpecialChars(t *testing.T) {
+	note := utils.TdNoteHref.Latex
+	note.Text = []string{`see \href{https://example.com/~user/a_b?x=1&y=2\%20z}{the \emph{docs}`, `of it} for test`}
+
+	expected := utils.TdNoteHref.Markdown
+	expected.Text = []string{"see [the *docs* of it](https://example.com/~user/a_b?x=1&y=2%20z) for test"}
+
+	folder_path, _ := setupTest(t, note)
+
+	LatexParserTest(t, folder_path, expected)
+}
+
 func TestNoteNewline(t *testing.T) {
 	baseLatexParserTest(t, utils.TdNoteNewline)
 }
 
+func TestNewlineAfterText(t *testing.T) {
+	latex := utils.TdNoteNewline.Latex
+	latex.Text = []string{`some text for test\\`, ``, `another line with another\\`}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, utils.TdNoteNewline.Markdown)
+}
+
 func TestNoteItemize(t *testing.T) {
 	baseLatexParserTest(t, utils.TdNoteItemize)
 }
@@ -92,6 +167,446 @@ func TestNoteEnumerate(t *testing.T) {
 	baseLatexParserTest(t, utils.TdNoteEnumerate)
 }
 
+func TestNoteNestedList(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteNestedList)
+}
+
+func TestNoteDeepList(t *testing.T) {
+	note := utils.TdTextOnly.Latex
+	note.Text = []string{
+		`\begin{itemize} \item first item`,
+		`  spanning two lines`,
+		`  \begin{enumerate}`,
+		`  \item second level`,
+		`    \begin{itemize}`,
+		`    \item third level`,
+		`    \begin{quote}`,
+		`    quoted \textbf{deep}`,
+		`    \end{quote}`,
+		`    \end{itemize}`,
+		`  \end{enumerate}`,
+		`  back in the first item`,
+		`\item last \end{itemize}`,
+	}
+
+	expected := utils.TdTextOnly.Markdown
+	expected.Text = []string{
+		`* first item spanning two lines`,
+		`    1. second level`,
+		`        * third level`,
+		`            > quoted **deep**`,
+		`    back in the first item`,
+		`* last`,
+	}
+
+	folder_path, _ := setupTest(t, note)
+
+	LatexParserTest(t, folder_path, expected)
+}
+
+func TestNoteMath(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteMath)
+}
+
+func TestNoteMathDelimiters(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`inline \(a_1\) and $$b$$`,
+		`\begin{equation}`,
+		`  x^2 + y^2 = z^2`,
+		`\end{equation}`,
+		`\begin{itemize}`,
+		`\item sum`,
+		`\begin{equation*}`,
+		`  \sum i`,
+		`\end{equation*}`,
+		`\end{itemize}`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Text = []string{`inline $a_1$ and $$b$$`, `$$`, `x^2 + y^2 = z^2`, `$$`, `* sum $$\sum i$$`}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestNoteDollar(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`costs \$5 or \$6, set \texttt{\$HOME} and $x$`,
+		`\begin{quote}`,
+		`see \[`,
+		`  a + b`,
+		`\] here`,
+		`\end{quote}`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Text = []string{"costs \\$5 or \\$6, set `$HOME` and $x$", `> see $$`, `> a + b`, `> $$ here`}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestNoteTable(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteTable)
+}
+
+func TestNoteTablePipes(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteTablePipes)
+}
+
+func TestNoteTableColumns(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`\begin{tabular}[t]{@{}p{3cm}*{2}{c}@{}} \toprule`,
+		`Name & Left \& right & \\ \midrule`,
+		`a & $x_1$ & c \\ b \\`,
+		`\bottomrule`,
+		`\end{tabular}`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Text = []string{`| Name | Left & right |  |`, `| --- | :---: | :---: |`, `| a | $x_1$ | c |`, `| b |  |  |`}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestNoteTableInList(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`\begin{itemize}`,
+		`\item versions`,
+		`  \begin{tabular}{lr}`,
+		`  Tool & Version \\ git & 2.4`,
+		`  \end{tabular}`,
+		`\end{itemize}`,
+		`\begin{quote}`,
+		`\begin{tabular}{c}`,
+		`\hline quoted \\ \hline`,
+		`\end{tabular}`,
+		`\end{quote}`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Text = []string{`* versions`, `    | Tool | Version |`, `    | --- | ---: |`, `    | git | 2.4 |`, ``, `> | quoted |`, `> | :---: |`}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestNoteFootnote(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteFootnote)
+}
+
+func TestNoteFootnoteMultiline(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`a \textbf{bold\footnote{within}} remark\footnote{spanning`,
+		`two \emph{lines}}`,
+		`\begin{tabular}{l}`,
+		`cell\footnote{in a table}`,
+		`\end{tabular}`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Text = []string{`a **bold[^1]** remark[^2]`, `| cell[^3] |`, `| --- |`, ``, `[^1]: within`, `[^2]: spanning two *lines*`, `[^3]: in a table`}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestNoteCode(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteCode)
+}
+
+func TestNoteAccents(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteAccents)
+}
+
+func TestNoteTypewriterCharacters(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`run \texttt{cmd --verbose} or \texttt{\textbf{caf\'e}~x}, \texttt{a`,
+		`-- b} -- \lstinline{--c} \url{https://example.com/a--b}`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Text = []string{"run `cmd --verbose` or `**caf\\'e**~x`, `a -- b` – `--c` [https://example.com/a--b](https://example.com/a--b)"}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestNoteQuote(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteQuote)
+}
+
+func TestNoteQuotation(t *testing.T) {
+	latex := utils.TdTextOnly.Latex
+	latex.Text = []string{
+		`\begin{quotation} the steps:`,
+		`\begin{enumerate}`,
+		`\item first`,
+		`\item second`,
+		`\end{enumerate}`,
+		`\end{quotation} as quoted`,
+	}
+
+	markdown := utils.TdTextOnly.Markdown
+	// the text following the quote is a paragraph of its own, as otherwise it would be read as part of the quote
+	markdown.Text = []string{`> the steps:`, `> 1. first`, `> 2. second`, ``, `as quoted`}
+
+	folder_path, _ := setupTest(t, latex)
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
 func TestNoteVerbatim(t *testing.T) {
 	baseLatexParserTest(t, utils.TdNoteVerbatim)
 }
+
+func TestNoteTags(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteTags)
+}
+
+func TestNoteMetadata(t *testing.T) {
+	baseLatexParserTest(t, utils.TdNoteMetadata)
+}
+
+func TestMetadataAnyOrder(t *testing.T) {
+	folder_path := t.TempDir()
+
+	latex := utils.NoteToLatex(utils.TdNoteMetadata.Latex)
+
+	// the header lines, from the title to the last metadata line, shuffled
+	header := []string{latex[7], latex[3], latex[0], latex[5], latex[8], latex[1], latex[4], latex[2], latex[6], `\textbf{Reviewer:} Jo\~ao\\`}
+	latex = append(header, latex[9:]...)
+
+	if err := os.WriteFile(folder_path+"/metadata.tex", []byte(strings.Join(latex, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	markdown := utils.TdNoteMetadata.Markdown
+	markdown.Metadata = maps.Clone(markdown.Metadata)
+	markdown.Metadata["Reviewer"] = "João"
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestMetadataFormatting(t *testing.T) {
+	note := utils.TdTextOnly.Latex
+	note.Metadata = map[string]string{"Source": `The \emph{Book}`, "Link": `\href{https://a.b}{site}`}
+	note.Text = []string{`\textbf{Note:} sample line`}
+
+	folder_path, _ := setupTest(t, note)
+
+	markdown := utils.TdTextOnly.Markdown
+	markdown.Metadata = map[string]string{"Source": "The *Book*", "Link": "[site](https://a.b)"}
+	markdown.Text = []string{"**Note:** sample line"}
+
+	LatexParserTest(t, folder_path, markdown)
+}
+
+func TestMissingMetadataError(t *testing.T) {
+	folder_path := t.TempDir()
+
+	latex := utils.NoteToLatex(utils.TdTextOnly.Latex)
+	// without the created date
+	latex = append(latex[:2], latex[3:]...)
+
+	if err := os.WriteFile(folder_path+"/missing.tex", []byte(strings.Join(latex, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report the missing metadata", "missing metadata: Created", parse_err.Err.Error())
+}
+
+func TestUnknownTagError(t *testing.T) {
+	note := utils.TdTextOnly.Latex
+	note.Text = []string{"first line", `second line with \unknowntag{content}`}
+
+	folder_path, file_name := setupTest(t, note)
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report file path", folder_path+"/"+file_name, parse_err.File_path)
+	utils.FailNotEquals(t, "Failed to report note title", note.Title, parse_err.Title)
+	// 4 metadata lines and the line break preceding the note text
+	utils.FailNotEquals(t, "Failed to report line number", 7, parse_err.Line)
+}
+
+func TestUnclosedListError(t *testing.T) {
+	note := utils.TdTextOnly.Latex
+	note.Text = []string{"first line", `\begin{itemize}`, `\item item 1`}
+
+	folder_path, _ := setupTest(t, note)
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report note title", note.Title, parse_err.Title)
+	utils.FailNotEquals(t, "Failed to report line number", 7, parse_err.Line)
+}
+
+func TestInvalidDateError(t *testing.T) {
+	folder_path, _ := setupTest(t, utils.TdTextOnly.Latex)
+
+	file_path := folder_path + "/invalid_date.tex"
+
+	invalid_note := []string{
+		`\textbf{Title:} Invalid date\\`,
+		`\textbf{URL:} \url{Sample url}\\`,
+		`\textbf{Created:} 2024-03-05\\`,
+		`\textbf{Last Updated:} some day\\`,
+		`\\`,
+		`Sample line`,
+		`\hrulefill`,
+	}
+
+	if err := os.WriteFile(file_path, []byte(strings.Join(invalid_note, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report file path", file_path, parse_err.File_path)
+	utils.FailNotEquals(t, "Failed to report note title", "Invalid date", parse_err.Title)
+	utils.FailNotEquals(t, "Failed to report line number", 4, parse_err.Line)
+}
+
+func TestSyntaxError(t *testing.T) {
+	note := utils.TdTextOnly.Latex
+	note.Text = []string{"first line", `second line with a_b`}
+
+	folder_path, file_name := setupTest(t, note)
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report file path", folder_path+"/"+file_name, parse_err.File_path)
+	utils.FailNotEquals(t, "Failed to report note title", note.Title, parse_err.Title)
+	utils.FailNotEquals(t, "Failed to report line number", 7, parse_err.Line)
+	utils.FailNotEquals(t, "Failed to report column", 19, parse_err.Column)
+}
+
+func TestSyntaxErrorColumn(t *testing.T) {
+	note := utils.TdTextOnly.Latex
+	note.Text = []string{"first line", `\emph{abcdefghijklmnop} \'e \'e \'e then { bad`}
+
+	folder_path, _ := setupTest(t, note)
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report line number", 7, parse_err.Line)
+	// the column of the brace as written, before the accents are read
+	utils.FailNotEquals(t, "Failed to report column", 42, parse_err.Column)
+}
+
+func TestSkipInvalidNotes(t *testing.T) {
+	invalid_note := utils.TdTextOnly.Latex
+	invalid_note.Title = "Invalid note"
+	invalid_note.Text = []string{"first line", `second line with a_b`}
+
+	latex := utils.NoteToLatex(utils.TdTextOnly.Latex)
+	latex = append(latex, utils.NoteToLatex(invalid_note)...)
+	latex = append(latex, utils.NoteToLatex(utils.TdNoteBoldText.Latex)...)
+
+	folder_path := t.TempDir()
+	file_path := folder_path + "/sample.tex"
+
+	if err := os.WriteFile(file_path, []byte(strings.Join(latex, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Process_files(folder_path, "tex", true); err == nil {
+		t.Fatal("Failed to stop at the invalid note when strict")
+	}
+
+	processed_notes, err := Process_files(folder_path, "tex", false)
+
+	var skipped ParseErrors
+
+	utils.FailNotEquals(t, "Failed to return the errors of the skipped notes", true, errors.As(err, &skipped))
+	utils.FailNotEquals(t, "Failed to report the invalid note", "Invalid note", skipped[0].Title)
+	utils.FailNotEquals(t, "Failed to report line number", len(utils.NoteToLatex(utils.TdTextOnly.Latex))+7, skipped[0].Line)
+	utils.FailNotEquals(t, "Failed to keep the valid notes", 2, len(processed_notes[0].Notes))
+	utils.FailNotEquals(t, "Failed to keep the note after the invalid one", utils.TdNoteBoldText.Latex.Title, processed_notes[0].Notes[1].Title)
+}
+
+func TestSectionCategories(t *testing.T) {
+	latex := []string{`\section{tools}`, ``}
+	latex = append(latex, utils.NoteToLatex(utils.TdTextOnly.Latex)...)
+	latex = append(latex, `\subsection{docker\_compose}`, ``)
+	latex = append(latex, utils.NoteToLatex(utils.TdNoteBoldText.Latex)...)
+	latex = append(latex, `\section{git}`, ``)
+	latex = append(latex, utils.NoteToLatex(utils.TdNoteUrl.Latex)...)
+
+	folder_path := t.TempDir()
+
+	if err := os.WriteFile(folder_path+"/sample.tex", []byte(strings.Join(latex, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	processed_notes, err := Process_files(folder_path, "tex", true)
+
+	utils.FailNotEquals(t, "Failed to process files", nil, err)
+	utils.FailNotEquals(t, "Failed to process expected number of notes", 3, len(processed_notes[0].Notes))
+
+	for index, expected := range []string{"tools", "tools/docker_compose", "git"} {
+		utils.FailNotEquals(t, "Failed to read note category from sections", expected, processed_notes[0].NoteCategory(index, "other/folder"))
+	}
+}
+
+func TestSectionSeparatorError(t *testing.T) {
+	latex := []string{`\section{tools}`, ``}
+	latex = append(latex, utils.NoteToLatex(utils.TdTextOnly.Latex)...)
+	latex = append(latex, `\subsection{CI/CD}`, ``)
+	latex = append(latex, utils.NoteToLatex(utils.TdNoteUrl.Latex)...)
+
+	folder_path := t.TempDir()
+
+	if err := os.WriteFile(folder_path+"/sample.tex", []byte(strings.Join(latex, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Process_files(folder_path, "tex", true)
+
+	var parse_err *ParseError
+
+	utils.FailNotEquals(t, "Failed to return a parse error", true, errors.As(err, &parse_err))
+	utils.FailNotEquals(t, "Failed to report line number", len(utils.NoteToLatex(utils.TdTextOnly.Latex))+3, parse_err.Line)
+}
+
+func TestSectionCategoryFolder(t *testing.T) {
+	file_notes := FileNotes{Sections: [][]string{nil, {"", "docker"}, {"", "", "compose"}}}
+
+	utils.FailNotEquals(t, "Failed to use folder category without sections", "tools/docker", file_notes.NoteCategory(0, "tools/docker"))
+	utils.FailNotEquals(t, "Failed to take missing sections from folder category", "tools/docker", file_notes.NoteCategory(1, "tools/docker"))
+	utils.FailNotEquals(t, "Failed to skip missing sections deeper than folder category", "tools/compose", file_notes.NoteCategory(2, "tools"))
+}
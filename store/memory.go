@@ -0,0 +1,447 @@
+package store
+
+import (
+	"cotonetes/types"
+	"cotonetes/utils"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var _ NoteStore = (*MemoryStore)(nil)
+
+// MemoryStore keeps the notes in memory, mostly for tests. Changes are visible right away, so Commit does nothing
+type MemoryStore struct {
+	notes map[int64]types.Note
+	// ids of the categories of each note
+	note_categories map[int64][]int64
+	// path of each category
+	categories map[int64]string
+
+	last_note_id     int64
+	last_category_id int64
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		notes:           make(map[int64]types.Note),
+		note_categories: make(map[int64][]int64),
+		categories:      make(map[int64]string),
+	}
+}
+
+// copy_note returns a copy of the note that does not share its slices, so stored notes can only change through the store
+func copy_note(note types.Note) types.Note {
+	note.Text = slices.Clone(note.Text)
+	note.Tags = utils.Normalise_tags(note.Tags)
+
+	if note.Images != nil {
+		images := make(map[string][]byte, len(note.Images))
+
+		for path, data := range note.Images {
+			images[path] = slices.Clone(data)
+		}
+
+		note.Images = images
+	}
+
+	note.Metadata = maps.Clone(note.Metadata)
+
+	return note
+}
+
+func (m *MemoryStore) findCategory(path string) (int64, bool) {
+	for cat_id, cat_path := range m.categories {
+		if cat_path == path {
+			return cat_id, true
+		}
+	}
+
+	return 0, false
+}
+
+func (m *MemoryStore) findExistingCategory(path string) (int64, error) {
+	cat_id, found := m.findCategory(path)
+
+	if !found {
+		return 0, fmt.Errorf("category %s does not exist", path)
+	}
+
+	return cat_id, nil
+}
+
+func (m *MemoryStore) AddNote(note types.Note, category string) (int64, error) {
+	cat_id, err := m.CreateCategory(category)
+	if err != nil {
+		return 0, err
+	}
+
+	m.last_note_id++
+
+	m.notes[m.last_note_id] = copy_note(note)
+	m.note_categories[m.last_note_id] = []int64{cat_id}
+
+	return m.last_note_id, nil
+}
+
+func (m *MemoryStore) GetNote(note_id int64) (types.Note, error) {
+	note, found := m.notes[note_id]
+
+	if !found {
+		return types.Note{}, fmt.Errorf("note %d: %w", note_id, ErrNoteNotFound)
+	}
+
+	return copy_note(note), nil
+}
+
+func (m *MemoryStore) UpdateNote(note_id int64, note types.Note) error {
+	if _, found := m.notes[note_id]; !found {
+		return fmt.Errorf("note %d: %w", note_id, ErrNoteNotFound)
+	}
+
+	m.notes[note_id] = copy_note(note)
+
+	return nil
+}
+
+func (m *MemoryStore) DeleteNote(note_id int64) error {
+	if _, found := m.notes[note_id]; !found {
+		return fmt.Errorf("note %d: %w", note_id, ErrNoteNotFound)
+	}
+
+	delete(m.notes, note_id)
+	delete(m.note_categories, note_id)
+
+	return nil
+}
+
+// sorted_note_ids returns the ids of every note in ascending order, as map iteration order is random
+func (m *MemoryStore) sorted_note_ids() []int64 {
+	note_ids := make([]int64, 0, len(m.notes))
+
+	for note_id := range m.notes {
+		note_ids = append(note_ids, note_id)
+	}
+
+	slices.Sort(note_ids)
+
+	return note_ids
+}
+
+func (m *MemoryStore) ListNotes(category string, tag string) ([]utils.StoredNote, error) {
+	cat_id, err := m.findExistingCategory(category)
+	if err != nil {
+		return nil, err
+	}
+
+	tag = strings.TrimSpace(tag)
+
+	notes := make([]utils.StoredNote, 0)
+
+	for _, note_id := range m.sorted_note_ids() {
+		note := m.notes[note_id]
+
+		if slices.Contains(m.note_categories[note_id], cat_id) && (tag == "" || slices.Contains(note.Tags, tag)) {
+			notes = append(notes, utils.StoredNote{Id: note_id, Note: copy_note(note)})
+		}
+	}
+
+	return notes, nil
+}
+
+var search_term_re = regexp.MustCompile(`"[^"]*"|\S+`)
+
+// search_terms turns the query into one case insensitive regular expression per term, quoted phrases matching
+// their words in sequence and prefix* terms matching any word starting with the prefix
+func search_terms(query string) ([]*regexp.Regexp, error) {
+	terms := make([]*regexp.Regexp, 0)
+
+	for _, term := range search_term_re.FindAllString(query, -1) {
+		var pattern string
+
+		switch {
+		case strings.HasPrefix(term, `"`):
+			words := strings.Fields(strings.Trim(term, `"`))
+
+			if len(words) == 0 {
+				continue
+			}
+
+			for index := range words {
+				words[index] = regexp.QuoteMeta(words[index])
+			}
+
+			pattern = `(?i)\b` + strings.Join(words, `\W+`) + `\b`
+		case term == "AND":
+			// every term must match already
+			continue
+		case strings.HasSuffix(term, "*") && len(term) > 1:
+			pattern = `(?i)\b` + regexp.QuoteMeta(strings.TrimSuffix(term, "*")) + `\w*`
+		default:
+			pattern = `(?i)\b` + regexp.QuoteMeta(term) + `\b`
+		}
+
+		terms = append(terms, regexp.MustCompile(pattern))
+	}
+
+	if len(terms) == 0 {
+		return nil, errors.New("empty search query")
+	}
+
+	return terms, nil
+}
+
+// search_snippet returns the first line of the note matching any of the terms, with the matches highlighted
+func search_snippet(note types.Note, terms []*regexp.Regexp) string {
+	for _, line := range append([]string{note.Title}, note.Text...) {
+		for _, term := range terms {
+			if term.MatchString(line) {
+				for _, highlight_term := range terms {
+					line = highlight_term.ReplaceAllString(line, "**$0**")
+				}
+
+				return line
+			}
+		}
+	}
+
+	return ""
+}
+
+// SearchNotes ranks the notes by the number of term matches. Unlike the sqlite full-text index, words are
+// matched as written, without any stemming or OR/NOT operators
+func (m *MemoryStore) SearchNotes(query string, category string, tag string, limit int) ([]utils.SearchResult, error) {
+	terms, err := search_terms(query)
+	if err != nil {
+		return nil, err
+	}
+
+	tag = strings.TrimSpace(tag)
+
+	type ranked_result struct {
+		Result  utils.SearchResult
+		Matches int
+	}
+
+	ranked := make([]ranked_result, 0)
+
+	for _, note_id := range m.sorted_note_ids() {
+		note := m.notes[note_id]
+
+		if tag != "" && !slices.Contains(note.Tags, tag) {
+			continue
+		}
+
+		categories := make([]string, 0)
+		in_category := category == ""
+
+		for _, cat_id := range m.note_categories[note_id] {
+			path := m.categories[cat_id]
+
+			categories = append(categories, path)
+			in_category = in_category || path == category || strings.HasPrefix(path, category+utils.Category_separator)
+		}
+
+		if !in_category {
+			continue
+		}
+
+		content := strings.Join(append([]string{note.Title, note.Url}, note.Text...), "\n")
+		matches := 0
+
+		for _, term := range terms {
+			term_matches := len(term.FindAllStringIndex(content, -1))
+
+			if term_matches == 0 {
+				matches = 0
+				break
+			}
+
+			matches += term_matches
+		}
+
+		if matches == 0 {
+			continue
+		}
+
+		slices.Sort(categories)
+
+		ranked = append(ranked, ranked_result{
+			utils.SearchResult{
+				Note_id:    note_id,
+				Title:      note.Title,
+				Url:        note.Url,
+				Snippet:    search_snippet(note, terms),
+				Categories: categories,
+				Tags:       slices.Clone(note.Tags),
+			},
+			matches,
+		})
+	}
+
+	// stable, so notes with as many matches keep their id order
+	slices.SortStableFunc(ranked, func(a ranked_result, b ranked_result) int {
+		return b.Matches - a.Matches
+	})
+
+	results := make([]utils.SearchResult, 0, len(ranked))
+
+	for _, r := range ranked {
+		if limit >= 0 && len(results) == limit {
+			break
+		}
+
+		results = append(results, r.Result)
+	}
+
+	return results, nil
+}
+
+func (m *MemoryStore) CreateCategory(path string) (int64, error) {
+	var cat_id int64
+
+	names := strings.Split(path, utils.Category_separator)
+
+	for index := range names {
+		sub_path := strings.Join(names[:index+1], utils.Category_separator)
+
+		var found bool
+
+		if cat_id, found = m.findCategory(sub_path); !found {
+			m.last_category_id++
+
+			cat_id = m.last_category_id
+			m.categories[cat_id] = sub_path
+		}
+	}
+
+	return cat_id, nil
+}
+
+func (m *MemoryStore) ListCategories() ([]utils.CategoryNode, error) {
+	tree := make([]utils.CategoryNode, 0, len(m.categories))
+
+	for cat_id, path := range m.categories {
+		node := utils.CategoryNode{
+			Id:    cat_id,
+			Name:  path[strings.LastIndex(path, utils.Category_separator)+1:],
+			Path:  path,
+			Depth: strings.Count(path, utils.Category_separator),
+		}
+
+		for _, note_cat_ids := range m.note_categories {
+			if slices.Contains(note_cat_ids, cat_id) {
+				node.Note_count++
+			}
+
+			for _, note_cat_id := range note_cat_ids {
+				note_path := m.categories[note_cat_id]
+
+				if note_path == path || strings.HasPrefix(note_path, path+utils.Category_separator) {
+					node.Subtree_note_count++
+					break
+				}
+			}
+		}
+
+		tree = append(tree, node)
+	}
+
+	slices.SortFunc(tree, func(a utils.CategoryNode, b utils.CategoryNode) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return tree, nil
+}
+
+// setCategoryPath changes the path of the category and of all of its sub-categories, failing if the new path is taken
+func (m *MemoryStore) setCategoryPath(path string, new_path string) error {
+	if _, err := m.findExistingCategory(path); err != nil {
+		return err
+	}
+
+	if _, found := m.findCategory(new_path); found {
+		return fmt.Errorf("category %s already exists, merge the categories instead", new_path)
+	}
+
+	for cat_id, cat_path := range m.categories {
+		if cat_path == path {
+			m.categories[cat_id] = new_path
+		} else if strings.HasPrefix(cat_path, path+utils.Category_separator) {
+			m.categories[cat_id] = new_path + strings.TrimPrefix(cat_path, path)
+		}
+	}
+
+	return nil
+}
+
+func (m *MemoryStore) RenameCategory(path string, new_name string) error {
+	if new_name == "" || strings.Contains(new_name, utils.Category_separator) {
+		return fmt.Errorf("invalid category name: %q", new_name)
+	}
+
+	new_path := new_name
+	if index := strings.LastIndex(path, utils.Category_separator); index >= 0 {
+		new_path = path[:index] + utils.Category_separator + new_name
+	}
+
+	return m.setCategoryPath(path, new_path)
+}
+
+func (m *MemoryStore) MoveCategory(path string, new_parent_path string) error {
+	new_path := path[strings.LastIndex(path, utils.Category_separator)+1:]
+
+	if new_parent_path != "" {
+		if new_parent_path == path || strings.HasPrefix(new_parent_path, path+utils.Category_separator) {
+			return fmt.Errorf("category %s can not be moved into its own sub-category %s", path, new_parent_path)
+		}
+
+		if _, err := m.findExistingCategory(new_parent_path); err != nil {
+			return err
+		}
+
+		new_path = new_parent_path + utils.Category_separator + new_path
+	}
+
+	return m.setCategoryPath(path, new_path)
+}
+
+func (m *MemoryStore) DeleteCategory(path string) error {
+	cat_id, err := m.findExistingCategory(path)
+	if err != nil {
+		return err
+	}
+
+	note_count, child_count := 0, 0
+
+	for _, note_cat_ids := range m.note_categories {
+		if slices.Contains(note_cat_ids, cat_id) {
+			note_count++
+		}
+	}
+
+	for _, cat_path := range m.categories {
+		if strings.HasPrefix(cat_path, path+utils.Category_separator) && !strings.Contains(strings.TrimPrefix(cat_path, path+utils.Category_separator), utils.Category_separator) {
+			child_count++
+		}
+	}
+
+	if note_count > 0 || child_count > 0 {
+		return fmt.Errorf("category %s is not empty, it has %d notes and %d sub-categories", path, note_count, child_count)
+	}
+
+	delete(m.categories, cat_id)
+
+	return nil
+}
+
+func (m *MemoryStore) Commit() error {
+	return nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,34 @@
+package store
+
+import (
+	"cotonetes/utils"
+	"testing"
+)
+
+func TestMemoryNoteCrud(t *testing.T) {
+	testNoteCrud(t, NewMemoryStore())
+}
+
+func TestMemoryListNotes(t *testing.T) {
+	testListNotes(t, NewMemoryStore())
+}
+
+func TestMemorySearchNotes(t *testing.T) {
+	testSearchNotes(t, NewMemoryStore())
+}
+
+func TestMemoryCategories(t *testing.T) {
+	testCategories(t, NewMemoryStore())
+}
+
+func TestMemorySearchSnippet(t *testing.T) {
+	note_store := NewMemoryStore()
+
+	addNote(t, note_store, utils.TdTextOnly.Markdown, "a")
+
+	results, err := note_store.SearchNotes("line", "", "", 10)
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to highlight search term", "Sample **line**", results[0].Snippet)
+}
@@ -0,0 +1,139 @@
+package store
+
+import (
+	"cotonetes/types"
+	"cotonetes/utils"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+var _ NoteStore = (*SqliteStore)(nil)
+
+// SqliteStore keeps the notes in a sqlite database, running every operation inside a transaction that is
+// renewed on each Commit
+type SqliteStore struct {
+	db_manager utils.DatabaseManager
+	db         *sql.DB
+	tx         *sql.Tx
+}
+
+// OpenSqliteStore opens the database at the given path, which must already be at the latest schema version
+func OpenSqliteStore(db_path string) (*SqliteStore, error) {
+	s := &SqliteStore{db_manager: utils.DatabaseManager{Db_path: db_path}}
+
+	var err error
+
+	if s.db, err = s.db_manager.OpenDatabase(); err != nil {
+		return nil, err
+	}
+
+	if s.tx, err = s.db_manager.BeginTransaction(s.db); err != nil {
+		s.db.Close()
+		return nil, err
+	}
+
+	if err = s.db_manager.CheckSchemaVersion(s.tx); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *SqliteStore) AddNote(note types.Note, category string) (int64, error) {
+	cat_id, err := s.db_manager.CreateCategory(s.tx, category)
+	if err != nil {
+		return 0, err
+	}
+
+	note_id, err := s.db_manager.AddNote(s.tx, note)
+	if err != nil {
+		return 0, err
+	}
+
+	return note_id, s.db_manager.AddNoteCategory(s.tx, note_id, cat_id)
+}
+
+func (s *SqliteStore) GetNote(note_id int64) (types.Note, error) {
+	note, err := s.db_manager.GetNote(s.tx, note_id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return note, fmt.Errorf("note %d: %w", note_id, ErrNoteNotFound)
+	}
+
+	return note, err
+}
+
+func (s *SqliteStore) UpdateNote(note_id int64, note types.Note) error {
+	if _, err := s.GetNote(note_id); err != nil {
+		return err
+	}
+
+	return s.db_manager.UpdateNote(s.tx, note_id, note)
+}
+
+func (s *SqliteStore) DeleteNote(note_id int64) error {
+	if _, err := s.GetNote(note_id); err != nil {
+		return err
+	}
+
+	return s.db_manager.DeleteNote(s.tx, note_id)
+}
+
+func (s *SqliteStore) ListNotes(category string, tag string) ([]utils.StoredNote, error) {
+	cat_id, found, err := s.db_manager.FindCategory(s.tx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("category %s does not exist", category)
+	}
+
+	return s.db_manager.CategoryNotes(s.tx, cat_id, tag)
+}
+
+func (s *SqliteStore) SearchNotes(query string, category string, tag string, limit int) ([]utils.SearchResult, error) {
+	return s.db_manager.SearchNotes(s.tx, query, category, tag, limit)
+}
+
+func (s *SqliteStore) CreateCategory(path string) (int64, error) {
+	return s.db_manager.CreateCategory(s.tx, path)
+}
+
+func (s *SqliteStore) ListCategories() ([]utils.CategoryNode, error) {
+	return s.db_manager.CategoryTree(s.tx)
+}
+
+func (s *SqliteStore) RenameCategory(path string, new_name string) error {
+	return s.db_manager.RenameCategory(s.tx, path, new_name)
+}
+
+func (s *SqliteStore) MoveCategory(path string, new_parent_path string) error {
+	return s.db_manager.MoveCategory(s.tx, path, new_parent_path)
+}
+
+func (s *SqliteStore) DeleteCategory(path string) error {
+	return s.db_manager.DeleteCategory(s.tx, path)
+}
+
+func (s *SqliteStore) Commit() error {
+	if err := s.db_manager.CommitTransaction(s.tx); err != nil {
+		return err
+	}
+
+	var err error
+
+	s.tx, err = s.db_manager.BeginTransaction(s.db)
+
+	return err
+}
+
+func (s *SqliteStore) Close() error {
+	if s.tx != nil {
+		s.tx.Rollback()
+	}
+
+	return s.db.Close()
+}
@@ -0,0 +1,58 @@
+//go:build cgo
+
+package store
+
+import (
+	"cotonetes/utils"
+	"path/filepath"
+	"testing"
+)
+
+// newSqliteStore creates an empty database at the latest schema version and opens it as a store
+func newSqliteStore(t *testing.T) *SqliteStore {
+	db_manager := utils.DatabaseManager{Db_path: filepath.Join(t.TempDir(), "cotonetes.db")}
+
+	db, err := db_manager.OpenDatabase()
+
+	failOnError(t, err)
+
+	defer db.Close()
+
+	tx, err := db_manager.BeginTransaction(db)
+
+	failOnError(t, err)
+
+	// no database can be created when not built with the sqlite_fts5 tag
+	if available, err := db_manager.Fts5Available(tx); err != nil || !available {
+		tx.Rollback()
+		failOnError(t, err)
+		t.Skip(utils.ErrFts5Missing)
+	}
+
+	failOnError(t, db_manager.CreateDatabase(tx))
+	failOnError(t, db_manager.CommitTransaction(tx))
+
+	note_store, err := OpenSqliteStore(db_manager.Db_path)
+
+	failOnError(t, err)
+
+	t.Cleanup(func() { note_store.Close() })
+
+	return note_store
+}
+
+func TestSqliteNoteCrud(t *testing.T) {
+	testNoteCrud(t, newSqliteStore(t))
+}
+
+func TestSqliteListNotes(t *testing.T) {
+	testListNotes(t, newSqliteStore(t))
+}
+
+func TestSqliteSearchNotes(t *testing.T) {
+	testSearchNotes(t, newSqliteStore(t))
+}
+
+func TestSqliteCategories(t *testing.T) {
+	testCategories(t, newSqliteStore(t))
+}
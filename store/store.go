@@ -0,0 +1,44 @@
+package store
+
+import (
+	"cotonetes/types"
+	"cotonetes/utils"
+	"errors"
+)
+
+// ErrNoteNotFound is returned, wrapped, when a note id does not match any stored note
+var ErrNoteNotFound = errors.New("note not found")
+
+// NoteStore keeps the notes and the category tree they belong to. Categories are written as paths, e.g.
+// "tools/docker" (see utils.Category_separator). Changes are only kept once Commit is called
+type NoteStore interface {
+	// AddNote stores a new note in the given category, creating the category if needed, and returns its id
+	AddNote(note types.Note, category string) (int64, error)
+	GetNote(note_id int64) (types.Note, error)
+	UpdateNote(note_id int64, note types.Note) error
+	// DeleteNote removes the note from every category it belongs to
+	DeleteNote(note_id int64) error
+	// ListNotes returns the notes of the given category, leaving out its sub-categories, ordered by id.
+	// If tag is not empty, only notes with that tag are returned
+	ListNotes(category string, tag string) ([]utils.StoredNote, error)
+	// SearchNotes returns the notes matching the query, best matches first. Quoted "phrases" and prefix* terms
+	// are supported. If category is not empty, only notes in that category or in any of its sub-categories are
+	// returned. If tag is not empty, only notes with that tag are returned
+	SearchNotes(query string, category string, tag string, limit int) ([]utils.SearchResult, error)
+
+	// CreateCategory returns the id of the category with the given path, creating it and any missing parent category
+	CreateCategory(path string) (int64, error)
+	// ListCategories returns every category ordered by path, so each category comes right before its sub-categories
+	ListCategories() ([]utils.CategoryNode, error)
+	// RenameCategory changes the name of a category. Its sub-categories and notes follow it
+	RenameCategory(path string, new_name string) error
+	// MoveCategory moves a category, along with its sub-categories and notes, under another category.
+	// An empty parent path moves the category to the top of the tree
+	MoveCategory(path string, new_parent_path string) error
+	// DeleteCategory removes a category, which must have neither notes nor sub-categories
+	DeleteCategory(path string) error
+
+	Commit() error
+	// Close releases the store, discarding any change that was not committed
+	Close() error
+}
@@ -0,0 +1,179 @@
+package store
+
+import (
+	"cotonetes/types"
+	"cotonetes/utils"
+	"errors"
+	"testing"
+)
+
+// The tests in this file run against every NoteStore implementation, see memory_test.go and sqlite_test.go
+
+func failOnError(t *testing.T, err error) {
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func addNote(t *testing.T, note_store NoteStore, note types.Note, category string) int64 {
+	note_id, err := note_store.AddNote(note, category)
+
+	failOnError(t, err)
+
+	return note_id
+}
+
+func testNoteCrud(t *testing.T, note_store NoteStore) {
+	note := utils.TdNoteTags.Markdown
+
+	note_id := addNote(t, note_store, note, "tools/git")
+
+	stored_note, err := note_store.GetNote(note_id)
+
+	failOnError(t, err)
+
+	utils.FailNotEqualsStruct(t, "Failed to read added note", note, stored_note)
+
+	note.Text = []string{"Changed line"}
+	note.Tags = []string{"changed"}
+
+	failOnError(t, note_store.UpdateNote(note_id, note))
+
+	stored_note, err = note_store.GetNote(note_id)
+
+	failOnError(t, err)
+
+	utils.FailNotEqualsStruct(t, "Failed to update note", note, stored_note)
+
+	failOnError(t, note_store.DeleteNote(note_id))
+
+	_, err = note_store.GetNote(note_id)
+
+	utils.FailNotEquals(t, "Failed to delete note", true, errors.Is(err, ErrNoteNotFound))
+	utils.FailNotEquals(t, "Failed to report missing note on update", true, errors.Is(note_store.UpdateNote(note_id, note), ErrNoteNotFound))
+	utils.FailNotEquals(t, "Failed to report missing note on delete", true, errors.Is(note_store.DeleteNote(note_id), ErrNoteNotFound))
+}
+
+func testListNotes(t *testing.T, note_store NoteStore) {
+	first_id := addNote(t, note_store, utils.TdTextOnly.Markdown, "tools")
+	second_id := addNote(t, note_store, utils.TdNoteTags.Markdown, "tools")
+	addNote(t, note_store, utils.TdNoteBoldText.Markdown, "tools/git")
+
+	notes, err := note_store.ListNotes("tools", "")
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to leave out sub-category notes", 2, len(notes))
+	utils.FailNotEquals(t, "Failed to order notes by id", first_id, notes[0].Id)
+	utils.FailNotEqualsStruct(t, "Failed to list note", utils.TdTextOnly.Markdown, notes[0].Note)
+
+	notes, err = note_store.ListNotes("tools", "docker")
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to filter notes by tag", 1, len(notes))
+	utils.FailNotEquals(t, "Failed to return tagged note", second_id, notes[0].Id)
+
+	if _, err = note_store.ListNotes("missing", ""); err == nil {
+		t.Fatal("Failed to report missing category")
+	}
+}
+
+func testSearchNotes(t *testing.T, note_store NoteStore) {
+	rebase := types.Note{Title: "Git rebase", Url: "https://git-scm.com/docs/git-rebase", Created_date: utils.TdCreatedDate, Updated_date: utils.TdUpdatedDate, Text: []string{"Rebase the current branch"}, Tags: []string{"git"}}
+	docker := types.Note{Title: "Docker build", Url: "https://docs.docker.com/build", Created_date: utils.TdCreatedDate, Updated_date: utils.TdUpdatedDate, Text: []string{"Multi stage builds"}}
+
+	rebase_id := addNote(t, note_store, rebase, "tools/git")
+	docker_id := addNote(t, note_store, docker, "tools/docker")
+
+	results, err := note_store.SearchNotes("rebase", "", "", 10)
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to find note by term", 1, len(results))
+	utils.FailNotEquals(t, "Failed to return matching note", rebase_id, results[0].Note_id)
+	utils.FailNotEqualsStruct(t, "Failed to return note categories", []string{"tools/git"}, results[0].Categories)
+	utils.FailNotEqualsStruct(t, "Failed to return note tags", []string{"git"}, results[0].Tags)
+
+	results, err = note_store.SearchNotes(`"multi stage" build*`, "", "", 10)
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to find note by phrase and prefix", 1, len(results))
+	utils.FailNotEquals(t, "Failed to return phrase matching note", docker_id, results[0].Note_id)
+
+	results, err = note_store.SearchNotes("https", "tools/docker", "", 10)
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to filter search by category", 1, len(results))
+	utils.FailNotEquals(t, "Failed to return note of category", docker_id, results[0].Note_id)
+
+	results, err = note_store.SearchNotes("https", "tools", "git", 10)
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to filter search by tag", 1, len(results))
+	utils.FailNotEquals(t, "Failed to return tagged note", rebase_id, results[0].Note_id)
+
+	results, err = note_store.SearchNotes("https", "", "", 1)
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to limit search results", 1, len(results))
+}
+
+func testCategories(t *testing.T, note_store NoteStore) {
+	addNote(t, note_store, utils.TdTextOnly.Markdown, "tools/git")
+	addNote(t, note_store, utils.TdNoteTags.Markdown, "tools/docker")
+
+	_, err := note_store.CreateCategory("misc")
+
+	failOnError(t, err)
+
+	failOnError(t, note_store.RenameCategory("tools/docker", "containers"))
+	failOnError(t, note_store.MoveCategory("tools/git", "misc"))
+
+	if err = note_store.MoveCategory("misc", "misc/git"); err == nil {
+		t.Fatal("Failed to refuse moving a category into its own sub-category")
+	}
+
+	if err = note_store.RenameCategory("misc", "tools"); err == nil {
+		t.Fatal("Failed to refuse renaming a category to an existing one")
+	}
+
+	tree, err := note_store.ListCategories()
+
+	failOnError(t, err)
+
+	paths := make([]string, 0)
+
+	for _, node := range tree {
+		paths = append(paths, node.Path)
+	}
+
+	utils.FailNotEqualsStruct(t, "Failed to list categories", []string{"misc", "misc/git", "tools", "tools/containers"}, paths)
+	utils.FailNotEquals(t, "Failed to compute category depth", 1, tree[1].Depth)
+	utils.FailNotEquals(t, "Failed to compute category name", "git", tree[1].Name)
+	utils.FailNotEquals(t, "Failed to count category notes", 0, tree[2].Note_count)
+	utils.FailNotEquals(t, "Failed to count sub-category notes", 1, tree[2].Subtree_note_count)
+
+	if err = note_store.DeleteCategory("misc/git"); err == nil {
+		t.Fatal("Failed to refuse deleting a category with notes")
+	}
+
+	if err = note_store.DeleteCategory("tools"); err == nil {
+		t.Fatal("Failed to refuse deleting a category with sub-categories")
+	}
+
+	_, err = note_store.CreateCategory("empty")
+
+	failOnError(t, err)
+	failOnError(t, note_store.DeleteCategory("empty"))
+
+	tree, err = note_store.ListCategories()
+
+	failOnError(t, err)
+
+	utils.FailNotEquals(t, "Failed to delete category", 4, len(tree))
+}
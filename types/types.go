@@ -1,9 +1,31 @@
 package types
 
+import (
+	"time"
+)
+
 type Note struct {
 	Title string
 	Url string
-	Created_date string
-	Updated_date string
+	Created_date time.Time
+	Updated_date time.Time
 	Text []string
+	Tags []string
+	// contents of the image files shown in the text, by the path the text refers to them with
+	Images map[string][]byte
+	// metadata lines other than the title, url, dates and tags, e.g. Author, by key
+	Metadata map[string]string
+	// where the note was last imported from
+	Provenance Provenance
+}
+
+// Provenance is the part of a latex file a note was imported from
+type Provenance struct {
+	// absolute path of the file holding the note header
+	File_path string
+	// first and last line of the note in that file, its \hrulefill line included
+	Start_line int
+	End_line   int
+	// hash of those lines as written in the file, telling whether they changed since the import
+	Hash string
 }
@@ -0,0 +1,337 @@
+package utils
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Category_separator separates the category names in a category path, e.g. "tools/docker"
+const Category_separator = "/"
+
+type CategoryNode struct {
+	Id         int64
+	Name       string
+	Path       string
+	Depth      int
+	Note_count int
+	// number of notes in the category and in all of its sub-categories
+	Subtree_note_count int
+}
+
+func category_name(path string) string {
+	return path[strings.LastIndex(path, Category_separator)+1:]
+}
+
+func category_parent_path(path string) string {
+	if index := strings.LastIndex(path, Category_separator); index >= 0 {
+		return path[:index]
+	}
+
+	return ""
+}
+
+func is_category_in_subtree(path string, subtree_path string) bool {
+	return path == subtree_path || strings.HasPrefix(path, subtree_path+Category_separator)
+}
+
+func (d *DatabaseManager) findChildCategory(tx *sql.Tx, parent_id sql.NullInt64, name string) (int64, bool, error) {
+	var cat_id int64
+
+	select_category_stmt := `select id from categories where parent_id is $1 and name = $2;`
+
+	err := tx.QueryRow(select_category_stmt, parent_id, name).Scan(&cat_id)
+
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, &DatabaseError{select_category_stmt, "", err}
+	}
+
+	return cat_id, true, nil
+}
+
+// FindCategory returns the id of the category with the given path. The boolean return value is false if there is no such category
+func (d *DatabaseManager) FindCategory(tx *sql.Tx, path string) (int64, bool, error) {
+	var parent_id sql.NullInt64
+
+	for _, name := range strings.Split(path, Category_separator) {
+		cat_id, found, err := d.findChildCategory(tx, parent_id, name)
+		if err != nil || !found {
+			return 0, false, err
+		}
+
+		parent_id = sql.NullInt64{Int64: cat_id, Valid: true}
+	}
+
+	return parent_id.Int64, true, nil
+}
+
+func (d *DatabaseManager) findExistingCategory(tx *sql.Tx, path string) (int64, error) {
+	cat_id, found, err := d.FindCategory(tx, path)
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, fmt.Errorf("category %s does not exist", path)
+	}
+
+	return cat_id, nil
+}
+
+// CreateCategory returns the id of the category with the given path, creating it and any missing parent category
+func (d *DatabaseManager) CreateCategory(tx *sql.Tx, path string) (int64, error) {
+	var parent_id sql.NullInt64
+
+	insert_category_stmt := `insert into categories (name, parent_id) values ($1, $2);`
+
+	for _, name := range strings.Split(path, Category_separator) {
+		cat_id, found, err := d.findChildCategory(tx, parent_id, name)
+		if err != nil {
+			return 0, err
+		}
+
+		if !found {
+			res, err := tx.Exec(insert_category_stmt, name, parent_id)
+			if err != nil {
+				return 0, &DatabaseError{insert_category_stmt, "", err}
+			}
+
+			if cat_id, err = res.LastInsertId(); err != nil {
+				return 0, &DatabaseError{insert_category_stmt, "", err}
+			}
+		}
+
+		parent_id = sql.NullInt64{Int64: cat_id, Valid: true}
+	}
+
+	return parent_id.Int64, nil
+}
+
+// CategoryTree returns every category ordered by path, so each category comes right before its sub-categories
+func (d *DatabaseManager) CategoryTree(tx *sql.Tx) ([]CategoryNode, error) {
+	select_tree_stmt := `
+	select category_paths.id, categories.name, category_paths.path, category_paths.depth,
+		(select count(*) from note_categories where note_categories.category_id = category_paths.id),
+		(select count(distinct note_categories.note_id) from note_categories inner join category_paths as sub_paths on note_categories.category_id = sub_paths.id
+			where sub_paths.path = category_paths.path or substr(sub_paths.path, 1, length(category_paths.path) + 1) = category_paths.path || $1)
+	from category_paths inner join categories on categories.id = category_paths.id
+	order by category_paths.path;`
+
+	rows, err := tx.Query(select_tree_stmt, Category_separator)
+	if err != nil {
+		return nil, &DatabaseError{select_tree_stmt, "", err}
+	}
+
+	defer rows.Close()
+
+	tree := make([]CategoryNode, 0)
+
+	for rows.Next() {
+		var node CategoryNode
+
+		if err = rows.Scan(&node.Id, &node.Name, &node.Path, &node.Depth, &node.Note_count, &node.Subtree_note_count); err != nil {
+			return nil, &DatabaseError{select_tree_stmt, "", err}
+		}
+
+		tree = append(tree, node)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{select_tree_stmt, "", err}
+	}
+
+	return tree, nil
+}
+
+// RenameCategory changes the name of the category with the given path. Its sub-categories and notes follow it
+func (d *DatabaseManager) RenameCategory(tx *sql.Tx, path string, new_name string) error {
+	if new_name == "" || strings.Contains(new_name, Category_separator) {
+		return fmt.Errorf("invalid category name: %q", new_name)
+	}
+
+	cat_id, err := d.findExistingCategory(tx, path)
+	if err != nil {
+		return err
+	}
+
+	new_path := new_name
+	if parent_path := category_parent_path(path); parent_path != "" {
+		new_path = parent_path + Category_separator + new_name
+	}
+
+	if _, found, err := d.FindCategory(tx, new_path); err != nil {
+		return err
+	} else if found {
+		return fmt.Errorf("category %s already exists, merge the categories instead", new_path)
+	}
+
+	rename_category_stmt := `update categories set name = $1 where id = $2;`
+
+	if _, err = tx.Exec(rename_category_stmt, new_name, cat_id); err != nil {
+		return &DatabaseError{rename_category_stmt, "", err}
+	}
+
+	return nil
+}
+
+func (d *DatabaseManager) setCategoryParent(tx *sql.Tx, cat_id int64, parent_id sql.NullInt64) error {
+	move_category_stmt := `update categories set parent_id = $1 where id = $2;`
+
+	if _, err := tx.Exec(move_category_stmt, parent_id, cat_id); err != nil {
+		return &DatabaseError{move_category_stmt, "", err}
+	}
+
+	return nil
+}
+
+// MoveCategory moves the category with the given path, along with its sub-categories and notes, under the
+// parent category with the given path. An empty parent path moves the category to the top of the tree
+func (d *DatabaseManager) MoveCategory(tx *sql.Tx, path string, new_parent_path string) error {
+	cat_id, err := d.findExistingCategory(tx, path)
+	if err != nil {
+		return err
+	}
+
+	var parent_id sql.NullInt64
+	new_path := category_name(path)
+
+	if new_parent_path != "" {
+		if is_category_in_subtree(new_parent_path, path) {
+			return fmt.Errorf("category %s can not be moved into its own sub-category %s", path, new_parent_path)
+		}
+
+		if parent_id.Int64, err = d.findExistingCategory(tx, new_parent_path); err != nil {
+			return err
+		}
+
+		parent_id.Valid = true
+		new_path = new_parent_path + Category_separator + new_path
+	}
+
+	if _, found, err := d.FindCategory(tx, new_path); err != nil {
+		return err
+	} else if found {
+		return fmt.Errorf("category %s already exists, merge the categories instead", new_path)
+	}
+
+	return d.setCategoryParent(tx, cat_id, parent_id)
+}
+
+// DeleteCategory removes the category with the given path, which must have neither notes nor sub-categories
+func (d *DatabaseManager) DeleteCategory(tx *sql.Tx, path string) error {
+	cat_id, err := d.findExistingCategory(tx, path)
+	if err != nil {
+		return err
+	}
+
+	var note_count, child_count int
+
+	count_stmt := `select (select count(*) from note_categories where category_id = $1), (select count(*) from categories where parent_id = $1);`
+
+	if err = tx.QueryRow(count_stmt, cat_id).Scan(&note_count, &child_count); err != nil {
+		return &DatabaseError{count_stmt, "", err}
+	}
+
+	if note_count > 0 || child_count > 0 {
+		return fmt.Errorf("category %s is not empty, it has %d notes and %d sub-categories", path, note_count, child_count)
+	}
+
+	delete_category_stmt := `delete from categories where id = $1;`
+
+	if _, err = tx.Exec(delete_category_stmt, cat_id); err != nil {
+		return &DatabaseError{delete_category_stmt, "", err}
+	}
+
+	return nil
+}
+
+// MergeCategory moves the notes and sub-categories of the source category into the target category and deletes
+// the source category. Sub-categories with the same name on both sides are merged as well
+func (d *DatabaseManager) MergeCategory(tx *sql.Tx, source_path string, target_path string) error {
+	if is_category_in_subtree(target_path, source_path) {
+		return fmt.Errorf("category %s can not be merged into itself or its sub-category %s", source_path, target_path)
+	}
+
+	source_id, err := d.findExistingCategory(tx, source_path)
+	if err != nil {
+		return err
+	}
+
+	target_id, err := d.findExistingCategory(tx, target_path)
+	if err != nil {
+		return err
+	}
+
+	return d.mergeCategory(tx, source_id, target_id)
+}
+
+func (d *DatabaseManager) mergeCategory(tx *sql.Tx, source_id int64, target_id int64) error {
+	// a note already in both categories keeps a single link to the target category
+	link_notes_stmt := `insert or ignore into note_categories (note_id, category_id) select note_id, $1 from note_categories where category_id = $2;`
+
+	if _, err := tx.Exec(link_notes_stmt, target_id, source_id); err != nil {
+		return &DatabaseError{link_notes_stmt, "", err}
+	}
+
+	unlink_notes_stmt := `delete from note_categories where category_id = $1;`
+
+	if _, err := tx.Exec(unlink_notes_stmt, source_id); err != nil {
+		return &DatabaseError{unlink_notes_stmt, "", err}
+	}
+
+	select_children_stmt := `select id, name from categories where parent_id = $1;`
+
+	rows, err := tx.Query(select_children_stmt, source_id)
+	if err != nil {
+		return &DatabaseError{select_children_stmt, "", err}
+	}
+
+	children := make(map[int64]string)
+
+	for rows.Next() {
+		var child_id int64
+		var name string
+
+		if err = rows.Scan(&child_id, &name); err != nil {
+			rows.Close()
+			return &DatabaseError{select_children_stmt, "", err}
+		}
+
+		children[child_id] = name
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return &DatabaseError{select_children_stmt, "", err}
+	}
+
+	target := sql.NullInt64{Int64: target_id, Valid: true}
+
+	for child_id, name := range children {
+		target_child_id, found, err := d.findChildCategory(tx, target, name)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			err = d.mergeCategory(tx, child_id, target_child_id)
+		} else {
+			err = d.setCategoryParent(tx, child_id, target)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	delete_category_stmt := `delete from categories where id = $1;`
+
+	if _, err = tx.Exec(delete_category_stmt, source_id); err != nil {
+		return &DatabaseError{delete_category_stmt, "", err}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+func categoryTree(t *testing.T, db_manager DatabaseManager, tx *sql.Tx) map[string]CategoryNode {
+	tree, err := db_manager.CategoryTree(tx)
+
+	failOnError(t, err)
+
+	nodes := make(map[string]CategoryNode)
+
+	for _, node := range tree {
+		nodes[node.Path] = node
+	}
+
+	return nodes
+}
+
+func categoryNotes(t *testing.T, db_manager DatabaseManager, tx *sql.Tx, path string) int {
+	node, found := categoryTree(t, db_manager, tx)[path]
+
+	if !found {
+		t.Fatalf("Category %s not found", path)
+	}
+
+	return node.Note_count
+}
+
+////
+// Tests
+////
+
+func TestCreateCategoryTree(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	importNote(t, db_manager, db, "tools/docker", TdTextOnly.Markdown)
+	importNote(t, db_manager, db, "tools", TdNoteUrl.Markdown)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	tree := categoryTree(t, db_manager, tx)
+
+	FailNotEquals(t, "Failed to create expected number of categories", 2, len(tree))
+	FailNotEquals(t, "Failed to set category depth", 1, tree["tools/docker"].Depth)
+	FailNotEquals(t, "Failed to count category notes", 1, tree["tools"].Note_count)
+	FailNotEquals(t, "Failed to count sub-category notes", 2, tree["tools"].Subtree_note_count)
+}
+
+func TestRenameCategory(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	importNote(t, db_manager, db, "tools/docker", TdTextOnly.Markdown)
+	importNote(t, db_manager, db, "tools/git", TdNoteUrl.Markdown)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	failOnError(t, db_manager.RenameCategory(tx, "tools", "software"))
+
+	FailNotEquals(t, "Failed to keep notes of renamed category sub-categories", 1, categoryNotes(t, db_manager, tx, "software/docker"))
+
+	if err := db_manager.RenameCategory(tx, "software/docker", "git"); err == nil {
+		t.Fatal("Failed to refuse renaming category to the name of a sibling")
+	}
+}
+
+func TestMoveCategory(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	importNote(t, db_manager, db, "tools/docker/compose", TdTextOnly.Markdown)
+	importNote(t, db_manager, db, "devops", TdNoteUrl.Markdown)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	failOnError(t, db_manager.MoveCategory(tx, "tools/docker", "devops"))
+
+	tree := categoryTree(t, db_manager, tx)
+
+	FailNotEquals(t, "Failed to move sub-categories along with category", 1, tree["devops/docker/compose"].Note_count)
+	FailNotEquals(t, "Failed to update moved category depth", 2, tree["devops/docker/compose"].Depth)
+
+	failOnError(t, db_manager.MoveCategory(tx, "devops/docker", ""))
+
+	FailNotEquals(t, "Failed to move category to the top of the tree", 1, categoryNotes(t, db_manager, tx, "docker/compose"))
+
+	if err := db_manager.MoveCategory(tx, "docker", "docker/compose"); err == nil {
+		t.Fatal("Failed to refuse moving category into its own sub-category")
+	}
+}
+
+func TestMergeCategory(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	importNote(t, db_manager, db, "old/docker", TdTextOnly.Markdown)
+	importNote(t, db_manager, db, "old", TdNoteUrl.Markdown)
+	importNote(t, db_manager, db, "new/docker", TdNoteBoldText.Markdown)
+	importNote(t, db_manager, db, "old/git", TdNoteItemize.Markdown)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	failOnError(t, db_manager.MergeCategory(tx, "old", "new"))
+
+	tree := categoryTree(t, db_manager, tx)
+
+	_, found := tree["old"]
+
+	FailNotEquals(t, "Failed to delete merged category", false, found)
+	FailNotEquals(t, "Failed to move notes to target category", 1, tree["new"].Note_count)
+	FailNotEquals(t, "Failed to merge sub-categories with the same name", 2, tree["new/docker"].Note_count)
+	FailNotEquals(t, "Failed to move sub-categories", 1, tree["new/git"].Note_count)
+}
+
+func TestMigrateFlatCategories(t *testing.T) {
+	db_manager := DatabaseManager{filepath.Join(t.TempDir(), "cotonetes.db")}
+
+	db, err := db_manager.OpenDatabase()
+
+	failOnError(t, err)
+
+	defer db.Close()
+
+	skipWithoutFts5(t, db_manager, db)
+
+	tx := beginTransaction(t, db_manager, db)
+
+	// build a database at the last schema version using flat category paths
+	for _, m := range migrations[:4] {
+		if _, err := tx.Exec(m.Statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	failOnError(t, db_manager.setSchemaVersion(tx, 4))
+
+	flat_categories_stmt := `
+	insert into categories (id, category) values (1, 'tools/docker'), (2, 'languages');
+	insert into notes (id, title, url, created, last_updated, note) values (1, 'title', 'url', 0, 0, 'text'), (2, 'other', 'other url', 0, 0, 'text');
+	insert into note_categories (note_id, category_id) values (1, 1), (2, 2);
+	`
+
+	if _, err := tx.Exec(flat_categories_stmt); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = db_manager.Migrate(tx)
+
+	failOnError(t, err)
+
+	tree := categoryTree(t, db_manager, tx)
+
+	FailNotEquals(t, "Failed to create missing parent category", 0, tree["tools"].Note_count)
+	FailNotEquals(t, "Failed to keep notes linked to migrated category", 1, tree["tools/docker"].Note_count)
+	FailNotEquals(t, "Failed to keep notes linked to top category", 1, tree["languages"].Note_count)
+
+	failOnError(t, db_manager.CommitTransaction(tx))
+}
@@ -2,8 +2,10 @@ package utils
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 	_ "github.com/mattn/go-sqlite3"
-	"log"
+	"slices"
 	"strings"
 	"cotonetes/types"
 )
@@ -12,96 +14,462 @@ type DatabaseManager struct{
 	Db_path string
 }
 
-func (d *DatabaseManager) OpenDatabase() *sql.DB {
+func (d *DatabaseManager) OpenDatabase() (*sql.DB, error) {
 	db, err := sql.Open("sqlite3", d.Db_path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	return db
+	return db, nil
 }
 
-func (d *DatabaseManager) BeginTransaction(db *sql.DB) *sql.Tx {
-	tx, err := db.Begin()
+func (d *DatabaseManager) BeginTransaction(db *sql.DB) (*sql.Tx, error) {
+	return db.Begin()
+}
+
+func (d *DatabaseManager) CommitTransaction(tx *sql.Tx) error {
+	return tx.Commit()
+}
+
+// CreateDatabase creates the database schema if the database is empty, otherwise it checks that the
+// existing schema is up to date
+func (d *DatabaseManager) CreateDatabase(tx *sql.Tx) error {
+	version, err := d.SchemaVersion(tx)
 	if err != nil {
-		log.Fatal(err)
+		return err
+	}
+
+	if version == 0 {
+		_, _, err = d.Migrate(tx)
+		return err
 	}
 
-	return tx
+	return d.CheckSchemaVersion(tx)
 }
 
-func (d *DatabaseManager) CommitTransaction(tx *sql.Tx) {
-	if err := tx.Commit(); err != nil {
-		log.Fatal(err)
+func (d *DatabaseManager) AddNote(tx *sql.Tx, note types.Note) (int64, error) {
+	var err error
+	var res sql.Result
+
+	insert_note_stmt := `insert into notes (title, url, canonical_url, created, last_updated, note) values ($1, $2, $3, $4, $5, $6);`
+
+	if res, err = tx.Exec(insert_note_stmt, note.Title, note.Url, Canonical_url(note.Url), note.Created_date.Unix(), note.Updated_date.Unix(), strings.Join(note.Text, "\n")); err != nil {
+		return 0, &DatabaseError{insert_note_stmt, note.Title, err}
+	}
+
+	var note_id int64
+
+	if note_id, err = res.LastInsertId(); err != nil {
+		return 0, &DatabaseError{insert_note_stmt, note.Title, err}
+	}
+
+	if err = d.setNoteTags(tx, note_id, note.Tags); err != nil {
+		return 0, note_error(err, note)
 	}
+
+	if err = d.setNoteImages(tx, note_id, note.Images); err != nil {
+		return 0, note_error(err, note)
+	}
+
+	if err = d.setNoteMetadata(tx, note_id, note.Metadata); err != nil {
+		return 0, note_error(err, note)
+	}
+
+	if err = d.setNoteProvenance(tx, note_id, note.Provenance); err != nil {
+		return 0, note_error(err, note)
+	}
+
+	return note_id, nil
 }
 
-func (d *DatabaseManager) CreateDatabase(tx *sql.Tx) {
-	create_tables_stmt := `
-	create table categories (id INTEGER PRIMARY KEY, category TEXT UNIQUE NOT NULL);
-	create table notes (id INTEGER PRIMARY KEY, title TEXT NOT NULL, url TEXT NOT NULL, created INTEGER NOT NULL, last_updated INTEGER NOT NULL, note TEXT NOT NULL);
-	create table note_categories (note_id INTEGER, category_id INTEGER, FOREIGN KEY(note_id) REFERENCES notes(id), FOREIGN KEY(category_id) REFERENCES categories(id), PRIMARY KEY(note_id, category_id));
-	`
+func (d *DatabaseManager) AddNoteCategory(tx *sql.Tx, note_id int64, cat_id int64) error {
+	insert_note_category_stmt := `insert into note_categories (note_id, category_id) values ($1, $2);`
 
-	if _, err := tx.Exec(create_tables_stmt); err != nil {
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			log.Fatalf("%q: unable to rollback: %q\n", err, rollbackErr)
-		}
-		log.Fatalf("%q: %s\n", err, create_tables_stmt)
+	if _, err := tx.Exec(insert_note_category_stmt, note_id, cat_id); err != nil {
+		return &DatabaseError{insert_note_category_stmt, "", err}
 	}
+
+	return nil
 }
 
-func (d *DatabaseManager) CreateCategory(tx *sql.Tx, category string) int64 {
-	var err error
-	var res sql.Result
+// FindNote looks up the note with the given url linked to the given category.
+// The boolean return value is false if there is no such note
+func (d *DatabaseManager) FindNote(tx *sql.Tx, url string, cat_id int64) (int64, types.Note, bool, error) {
+	var note_id int64
+	var note types.Note
+	var created, updated int64
+	var text string
+
+	select_note_stmt := `select notes.id, notes.title, notes.url, notes.created, notes.last_updated, notes.note from notes inner join note_categories on notes.id = note_categories.note_id where notes.url = $1 and note_categories.category_id = $2;`
+
+	err := tx.QueryRow(select_note_stmt, url, cat_id).Scan(&note_id, &note.Title, &note.Url, &created, &updated, &text)
 
-	insert_category_stmt := `insert into categories (category) values ($1);`
+	if err == sql.ErrNoRows {
+		return 0, note, false, nil
+	} else if err != nil {
+		return 0, note, false, &DatabaseError{select_note_stmt, "", err}
+	}
+
+	note.Created_date = Timestamp_to_time(created)
+	note.Updated_date = Timestamp_to_time(updated)
+	note.Text = strings.Split(text, "\n")
+
+	if note.Tags, err = d.noteTags(tx, note_id); err != nil {
+		return 0, note, false, err
+	}
+
+	if note.Images, err = d.noteImages(tx, note_id); err != nil {
+		return 0, note, false, err
+	}
+
+	if note.Metadata, err = d.noteMetadata(tx, note_id); err != nil {
+		return 0, note, false, err
+	}
+
+	return note_id, note, true, nil
+}
 
-	if res, err = tx.Exec(insert_category_stmt, category); err != nil {
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			log.Fatalf("%q: unable to rollback: %q\n", err, rollbackErr)
+// GetNote returns the note with the given id, failing with sql.ErrNoRows if there is no such note
+func (d *DatabaseManager) GetNote(tx *sql.Tx, note_id int64) (types.Note, error) {
+	var note types.Note
+	var created, updated int64
+	var text string
+
+	select_note_stmt := `select title, url, created, last_updated, note from notes where id = $1;`
+
+	if err := tx.QueryRow(select_note_stmt, note_id).Scan(&note.Title, &note.Url, &created, &updated, &text); err != nil {
+		return note, &DatabaseError{select_note_stmt, "", err}
+	}
+
+	note.Created_date = Timestamp_to_time(created)
+	note.Updated_date = Timestamp_to_time(updated)
+	note.Text = strings.Split(text, "\n")
+
+	tags, err := d.noteTags(tx, note_id)
+	if err != nil {
+		return note, err
+	}
+
+	note.Tags = tags
+
+	if note.Images, err = d.noteImages(tx, note_id); err != nil {
+		return note, err
+	}
+
+	if note.Metadata, err = d.noteMetadata(tx, note_id); err != nil {
+		return note, err
+	}
+
+	if note.Provenance, err = d.noteProvenance(tx, note_id); err != nil {
+		return note, err
+	}
+
+	return note, nil
+}
+
+// StoredNote is a note along with its id in the database
+type StoredNote struct {
+	Id   int64
+	Note types.Note
+}
+
+// CategoryNotes returns the notes linked to the given category, leaving out its sub-categories, ordered by id.
+// If tag is not empty, only notes with that tag are returned
+func (d *DatabaseManager) CategoryNotes(tx *sql.Tx, cat_id int64, tag string) ([]StoredNote, error) {
+	select_notes_stmt := `
+	select notes.id, notes.title, notes.url, notes.created, notes.last_updated, notes.note
+	from notes inner join note_categories on notes.id = note_categories.note_id
+	where note_categories.category_id = $1
+		and ($2 = '' or exists (
+			select 1 from note_tags inner join tags on note_tags.tag_id = tags.id
+			where note_tags.note_id = notes.id and tags.name = $2))
+	order by notes.id;`
+
+	rows, err := tx.Query(select_notes_stmt, cat_id, strings.TrimSpace(tag))
+	if err != nil {
+		return nil, &DatabaseError{select_notes_stmt, "", err}
+	}
+
+	notes := make([]StoredNote, 0)
+
+	for rows.Next() {
+		var stored StoredNote
+		var created, updated int64
+		var text string
+
+		if err = rows.Scan(&stored.Id, &stored.Note.Title, &stored.Note.Url, &created, &updated, &text); err != nil {
+			rows.Close()
+			return nil, &DatabaseError{select_notes_stmt, "", err}
 		}
-		log.Fatal("%q: %s\n", err, insert_category_stmt)
+
+		stored.Note.Created_date = Timestamp_to_time(created)
+		stored.Note.Updated_date = Timestamp_to_time(updated)
+		stored.Note.Text = strings.Split(text, "\n")
+
+		notes = append(notes, stored)
 	}
 
-	var cat_id int64
+	rows.Close()
 
-	if cat_id, err = res.LastInsertId(); err != nil {
-		log.Fatal(err)
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{select_notes_stmt, "", err}
 	}
 
-	return cat_id
+	// the tags, images and metadata are read once the notes query is closed, as a transaction can only run one query at a time
+	for index := range notes {
+		if notes[index].Note.Tags, err = d.noteTags(tx, notes[index].Id); err != nil {
+			return nil, note_error(err, notes[index].Note)
+		}
+
+		if notes[index].Note.Images, err = d.noteImages(tx, notes[index].Id); err != nil {
+			return nil, note_error(err, notes[index].Note)
+		}
+
+		if notes[index].Note.Metadata, err = d.noteMetadata(tx, notes[index].Id); err != nil {
+			return nil, note_error(err, notes[index].Note)
+		}
+	}
+
+	return notes, nil
 }
 
-func (d *DatabaseManager) AddNote(tx *sql.Tx, note types.Note) int64 {
-	var err error
-	var res sql.Result
+func (d *DatabaseManager) UpdateNote(tx *sql.Tx, note_id int64, note types.Note) error {
+	update_note_stmt := `update notes set title = $1, url = $2, canonical_url = $3, created = $4, last_updated = $5, note = $6 where id = $7;`
+
+	if _, err := tx.Exec(update_note_stmt, note.Title, note.Url, Canonical_url(note.Url), note.Created_date.Unix(), note.Updated_date.Unix(), strings.Join(note.Text, "\n"), note_id); err != nil {
+		return &DatabaseError{update_note_stmt, note.Title, err}
+	}
+
+	if err := d.setNoteTags(tx, note_id, note.Tags); err != nil {
+		return note_error(err, note)
+	}
+
+	if err := d.setNoteImages(tx, note_id, note.Images); err != nil {
+		return note_error(err, note)
+	}
 
-	insert_note_stmt := `insert into notes (title, url, created, last_updated, note) values ($1, $2, $3, $4, $5);`
+	if err := d.setNoteMetadata(tx, note_id, note.Metadata); err != nil {
+		return note_error(err, note)
+	}
+
+	return note_error(d.setNoteProvenance(tx, note_id, note.Provenance), note)
+}
 
-	if res, err = tx.Exec(insert_note_stmt, note.Title, note.Url, note.Created_date, note.Updated_date, strings.Join(note.Text, "\n")); err != nil {
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			log.Fatalf("%q: unable to rollback: %q\n", err, rollbackErr)
+// DeleteNote removes the note along with its categories, tags, images, metadata, provenance and revisions
+func (d *DatabaseManager) DeleteNote(tx *sql.Tx, note_id int64) error {
+	delete_note_stmts := []string{
+		`delete from note_categories where note_id = $1;`,
+		`delete from note_tags where note_id = $1;`,
+		`delete from note_images where note_id = $1;`,
+		`delete from note_metadata where note_id = $1;`,
+		`delete from note_provenance where note_id = $1;`,
+		`delete from note_merges where note_id = $1;`,
+		`delete from note_revisions where note_id = $1;`,
+		`delete from notes where id = $1;`,
+	}
+
+	for _, delete_stmt := range delete_note_stmts {
+		if _, err := tx.Exec(delete_stmt, note_id); err != nil {
+			return &DatabaseError{delete_stmt, "", err}
 		}
-		log.Fatal("%q: %s\n", err, insert_note_stmt)
 	}
 
-	var note_id int64
+	return nil
+}
 
-	if note_id, err = res.LastInsertId(); err != nil {
-		log.Fatal(err)
+type ImportResult int
+
+const (
+	NoteAdded ImportResult = iota
+	NoteUpdated
+	NoteUnchanged
+	// the note was already stored under another category, and was linked to the new category
+	NoteLinked
+)
+
+func notes_equal(a types.Note, b types.Note) bool {
+	return a.Title == b.Title &&
+		a.Url == b.Url &&
+		a.Created_date.Equal(b.Created_date) &&
+		a.Updated_date.Equal(b.Updated_date) &&
+		strings.Join(a.Text, "\n") == strings.Join(b.Text, "\n") &&
+		slices.Equal(Normalise_tags(a.Tags), Normalise_tags(b.Tags)) &&
+		Images_equal(a.Images, b.Images) &&
+		Metadata_equal(a.Metadata, b.Metadata)
+}
+
+// findIdenticalNote looks up a note, in any category, with the same contents as the given note
+func (d *DatabaseManager) findIdenticalNote(tx *sql.Tx, note types.Note) (int64, bool, error) {
+	select_identical_stmt := `select id from notes where url = $1 and title = $2 and created = $3 and last_updated = $4 and note = $5;`
+
+	rows, err := tx.Query(select_identical_stmt, note.Url, note.Title, note.Created_date.Unix(), note.Updated_date.Unix(), strings.Join(note.Text, "\n"))
+	if err != nil {
+		return 0, false, &DatabaseError{select_identical_stmt, note.Title, err}
 	}
 
-	return note_id
+	note_ids := make([]int64, 0)
+
+	for rows.Next() {
+		var note_id int64
+
+		if err = rows.Scan(&note_id); err != nil {
+			rows.Close()
+			return 0, false, &DatabaseError{select_identical_stmt, note.Title, err}
+		}
+
+		note_ids = append(note_ids, note_id)
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return 0, false, &DatabaseError{select_identical_stmt, note.Title, err}
+	}
+
+	for _, note_id := range note_ids {
+		tags, err := d.noteTags(tx, note_id)
+		if err != nil {
+			return 0, false, note_error(err, note)
+		}
+
+		if !slices.Equal(tags, Normalise_tags(note.Tags)) {
+			continue
+		}
+
+		images, err := d.noteImages(tx, note_id)
+		if err != nil {
+			return 0, false, note_error(err, note)
+		}
+
+		if !Images_equal(images, note.Images) {
+			continue
+		}
+
+		metadata, err := d.noteMetadata(tx, note_id)
+		if err != nil {
+			return 0, false, note_error(err, note)
+		}
+
+		if Metadata_equal(metadata, note.Metadata) {
+			return note_id, true, nil
+		}
+	}
+
+	return 0, false, nil
 }
 
-func (d *DatabaseManager) AddNoteCategory(tx *sql.Tx, note_id int64, cat_id int64) {
-	insert_note_category_stmt := `insert into note_categories (note_id, category_id) values ($1, $2);`
+// note_error sets the note title on database errors raised by statements that only know the note id
+func note_error(err error, note types.Note) error {
+	var db_err *DatabaseError
 
-	if _, err := tx.Exec(insert_note_category_stmt, note_id, cat_id); err != nil {
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			log.Fatalf("%q: unable to rollback: %q\n", err, rollbackErr)
+	if errors.As(err, &db_err) && db_err.Note == "" {
+		db_err.Note = note.Title
+	}
+
+	return err
+}
+
+// ImportNote inserts the note into the given category, or updates the note already stored under
+// the same url and category if its contents differ. A note identical to one stored under another category
+// is linked to the given category instead of being stored twice. Whatever the result, the note provenance is recorded
+func (d *DatabaseManager) ImportNote(tx *sql.Tx, note types.Note, cat_id int64) (ImportResult, error) {
+	note_id, stored_note, found, err := d.FindNote(tx, note.Url, cat_id)
+	if err != nil {
+		return NoteUnchanged, note_error(err, note)
+	}
+
+	// a note merged into another one is found from its own url
+	if !found {
+		if note_id, found, err = d.findMergedNote(tx, note.Url, cat_id); err != nil {
+			return NoteUnchanged, note_error(err, note)
+		} else if found {
+			if stored_note, err = d.GetNote(tx, note_id); err != nil {
+				return NoteUnchanged, note_error(err, note)
+			}
+		}
+	}
+
+	if !found {
+		if note_id, found, err = d.findIdenticalNote(tx, note); err != nil {
+			return NoteUnchanged, err
+		} else if found {
+			if err = d.AddNoteCategory(tx, note_id, cat_id); err != nil {
+				return NoteUnchanged, note_error(err, note)
+			}
+
+			return NoteLinked, note_error(d.setNoteProvenance(tx, note_id, note.Provenance), note)
+		}
+
+		if note_id, err = d.AddNote(tx, note); err != nil {
+			return NoteUnchanged, err
+		}
+
+		if err = d.AddNoteCategory(tx, note_id, cat_id); err != nil {
+			return NoteUnchanged, note_error(err, note)
 		}
-		log.Fatal("%q: %s\n", err, insert_note_category_stmt)
+
+		return NoteAdded, nil
+	}
+
+	// a note merged into the stored note is left as merged, unless it changed since
+	is_merged, err := d.isMergedNote(tx, note_id, note)
+	if err != nil {
+		return NoteUnchanged, note_error(err, note)
+	}
+
+	// the provenance is not part of the note contents, so an unchanged note may still have moved within its file
+	if is_merged || notes_equal(note, stored_note) {
+		return NoteUnchanged, note_error(d.setNoteProvenance(tx, note_id, note.Provenance), note)
+	}
+
+	if err = d.UpdateNote(tx, note_id, note); err != nil {
+		return NoteUnchanged, err
+	}
+
+	return NoteUpdated, nil
+}
+
+// LinkNoteCategory adds the note to the category with the given path, creating the category if needed
+func (d *DatabaseManager) LinkNoteCategory(tx *sql.Tx, note_id int64, path string) error {
+	cat_id, err := d.CreateCategory(tx, path)
+	if err != nil {
+		return err
+	}
+
+	link_note_stmt := `insert or ignore into note_categories (note_id, category_id) values ($1, $2);`
+
+	if _, err = tx.Exec(link_note_stmt, note_id, cat_id); err != nil {
+		return &DatabaseError{link_note_stmt, "", err}
+	}
+
+	return nil
+}
+
+// UnlinkNoteCategory removes the note from the category with the given path. A note must always belong to at
+// least one category, so removing it from its last category fails
+func (d *DatabaseManager) UnlinkNoteCategory(tx *sql.Tx, note_id int64, path string) error {
+	cat_id, err := d.findExistingCategory(tx, path)
+	if err != nil {
+		return err
 	}
+
+	var count int
+
+	count_categories_stmt := `select count(*) from note_categories where note_id = $1 and category_id != $2;`
+
+	if err = tx.QueryRow(count_categories_stmt, note_id, cat_id).Scan(&count); err != nil {
+		return &DatabaseError{count_categories_stmt, "", err}
+	}
+
+	if count == 0 {
+		return fmt.Errorf("note %d can not be removed from its only category %s", note_id, path)
+	}
+
+	unlink_note_stmt := `delete from note_categories where note_id = $1 and category_id = $2;`
+
+	if _, err = tx.Exec(unlink_note_stmt, note_id, cat_id); err != nil {
+		return &DatabaseError{unlink_note_stmt, "", err}
+	}
+
+	return nil
 }
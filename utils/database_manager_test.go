@@ -0,0 +1,305 @@
+package utils
+
+import (
+	"cotonetes/types"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func failOnError(t *testing.T, err error) {
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func beginTransaction(t *testing.T, db_manager DatabaseManager, db *sql.DB) *sql.Tx {
+	tx, err := db_manager.BeginTransaction(db)
+
+	failOnError(t, err)
+
+	return tx
+}
+
+// skipWithoutFts5 skips the test if the sqlite library lacks the FTS5 extension, i.e. when not built with the
+// sqlite_fts5 tag, as no database can be created without it
+func skipWithoutFts5(t *testing.T, db_manager DatabaseManager, db *sql.DB) {
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	available, err := db_manager.Fts5Available(tx)
+
+	failOnError(t, err)
+
+	if !available {
+		t.Skip(ErrFts5Missing)
+	}
+}
+
+func setupDatabase(t *testing.T) (DatabaseManager, *sql.DB) {
+	db_manager := DatabaseManager{filepath.Join(t.TempDir(), "cotonetes.db")}
+
+	db, err := db_manager.OpenDatabase()
+
+	failOnError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	skipWithoutFts5(t, db_manager, db)
+
+	tx := beginTransaction(t, db_manager, db)
+
+	failOnError(t, db_manager.CreateDatabase(tx))
+	failOnError(t, db_manager.CommitTransaction(tx))
+
+	return db_manager, db
+}
+
+func createCategory(t *testing.T, db_manager DatabaseManager, tx *sql.Tx, category string) int64 {
+	cat_id, err := db_manager.CreateCategory(tx, category)
+
+	failOnError(t, err)
+
+	return cat_id
+}
+
+func importNote(t *testing.T, db_manager DatabaseManager, db *sql.DB, category string, note types.Note) ImportResult {
+	tx := beginTransaction(t, db_manager, db)
+
+	result, err := db_manager.ImportNote(tx, note, createCategory(t, db_manager, tx, category))
+
+	failOnError(t, err)
+	failOnError(t, db_manager.CommitTransaction(tx))
+
+	return result
+}
+
+////
+// Tests
+////
+
+func TestCreateCategoryExisting(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	first_id := createCategory(t, db_manager, tx, "a/b")
+	second_id := createCategory(t, db_manager, tx, "a/b")
+
+	FailNotEquals(t, "Failed to reuse existing category", first_id, second_id)
+}
+
+func TestImportNote(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	note := TdTextOnly.Markdown
+
+	FailNotEquals(t, "Failed to add new note", NoteAdded, importNote(t, db_manager, db, "a", note))
+	FailNotEquals(t, "Failed to detect unchanged note", NoteUnchanged, importNote(t, db_manager, db, "a", note))
+
+	note.Text = []string{"Changed line"}
+
+	FailNotEquals(t, "Failed to detect updated note", NoteUpdated, importNote(t, db_manager, db, "a", note))
+
+	FailNotEquals(t, "Failed to link same note to another category", NoteLinked, importNote(t, db_manager, db, "b", note))
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	_, stored_note, found, err := db_manager.FindNote(tx, note.Url, createCategory(t, db_manager, tx, "a"))
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to find imported note", true, found)
+	FailNotEqualsStruct(t, "Failed to store updated note", note, stored_note)
+}
+
+func TestDatabaseErrorNote(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	note := TdTextOnly.Markdown
+
+	// a note linked to a category that does not exist violates no constraint, so force a failure by dropping the table
+	if _, err := tx.Exec(`drop table note_categories;`); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := db_manager.ImportNote(tx, note, 1)
+
+	var db_err *DatabaseError
+
+	FailNotEquals(t, "Failed to return a database error", true, errors.As(err, &db_err))
+	FailNotEquals(t, "Failed to report the note title", note.Title, db_err.Note)
+}
+
+func TestImportNoteLinked(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	note := TdNoteTags.Markdown
+
+	FailNotEquals(t, "Failed to add new note", NoteAdded, importNote(t, db_manager, db, "a", note))
+	FailNotEquals(t, "Failed to link identical note to another category", NoteLinked, importNote(t, db_manager, db, "b", note))
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	var count int
+
+	if err := tx.QueryRow(`select count(*) from notes;`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+
+	FailNotEquals(t, "Failed to store linked note once", 1, count)
+
+	_, stored_note, found, err := db_manager.FindNote(tx, note.Url, createCategory(t, db_manager, tx, "b"))
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to find linked note", true, found)
+	FailNotEqualsStruct(t, "Failed to store note tags", note, stored_note)
+}
+
+func TestImportNoteImages(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	note := TdNoteImage.Markdown
+
+	FailNotEquals(t, "Failed to add new note", NoteAdded, importNote(t, db_manager, db, "a", note))
+	FailNotEquals(t, "Failed to detect unchanged note", NoteUnchanged, importNote(t, db_manager, db, "a", note))
+
+	note.Images = map[string][]byte{"images/button_icon.png": []byte("new icon"), "images/build.png": []byte("build output")}
+
+	FailNotEquals(t, "Failed to detect changed image", NoteUpdated, importNote(t, db_manager, db, "a", note))
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	stored_note, err := db_manager.GetNote(tx, 1)
+
+	failOnError(t, err)
+
+	FailNotEqualsStruct(t, "Failed to store note images", note, stored_note)
+
+	failOnError(t, db_manager.DeleteNote(tx, 1))
+
+	images, err := db_manager.noteImages(tx, 1)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to delete note images", 0, len(images))
+}
+
+func TestImportNoteMetadata(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	note := TdNoteMetadata.Markdown
+
+	FailNotEquals(t, "Failed to add new note", NoteAdded, importNote(t, db_manager, db, "a", note))
+	FailNotEquals(t, "Failed to detect unchanged note", NoteUnchanged, importNote(t, db_manager, db, "a", note))
+
+	note.Metadata = map[string]string{"Author": "Ana Silva", "Status": "read"}
+
+	FailNotEquals(t, "Failed to detect changed metadata", NoteUpdated, importNote(t, db_manager, db, "a", note))
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	stored_note, err := db_manager.GetNote(tx, 1)
+
+	failOnError(t, err)
+
+	FailNotEqualsStruct(t, "Failed to store note metadata", note, stored_note)
+
+	failOnError(t, db_manager.DeleteNote(tx, 1))
+
+	metadata, err := db_manager.noteMetadata(tx, 1)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to delete note metadata", 0, len(metadata))
+}
+
+func TestImportNoteProvenance(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	file_path := t.TempDir() + "/notes.tex"
+
+	if err := os.WriteFile(file_path, []byte("first\nsecond\nthird\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	note := TdTextOnly.Markdown
+	note.Provenance = types.Provenance{File_path: file_path, Start_line: 1, End_line: 2, Hash: Source_hash([]string{"first", "second"})}
+
+	FailNotEquals(t, "Failed to add new note", NoteAdded, importNote(t, db_manager, db, "a", note))
+
+	// the note moved within its file
+	note.Provenance.Start_line, note.Provenance.End_line = 2, 3
+	note.Provenance.Hash = Source_hash([]string{"second", "third"})
+
+	FailNotEquals(t, "Failed to detect unchanged note", NoteUnchanged, importNote(t, db_manager, db, "a", note))
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	stored_note, err := db_manager.GetNote(tx, 1)
+
+	failOnError(t, err)
+
+	FailNotEqualsStruct(t, "Failed to record note provenance", note.Provenance, stored_note.Provenance)
+
+	lines, err := Provenance_lines(stored_note.Provenance)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to read unchanged note lines", stored_note.Provenance.Hash, Source_hash(lines))
+
+	if err := os.WriteFile(file_path, []byte("first\nsecond\nedited\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	lines, err = Provenance_lines(stored_note.Provenance)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to read changed note lines", "second\nedited", strings.Join(lines, "\n"))
+	FailNotEquals(t, "Failed to detect changed note lines", false, stored_note.Provenance.Hash == Source_hash(lines))
+
+	failOnError(t, db_manager.DeleteNote(tx, 1))
+
+	provenance, err := db_manager.noteProvenance(tx, 1)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to delete note provenance", "", provenance.File_path)
+}
+
+func TestLinkNoteCategory(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	importNote(t, db_manager, db, "a", TdTextOnly.Markdown)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	failOnError(t, db_manager.LinkNoteCategory(tx, 1, "b/c"))
+
+	_, _, found, err := db_manager.FindNote(tx, TdTextOnly.Markdown.Url, createCategory(t, db_manager, tx, "b/c"))
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to link note to new category", true, found)
+
+	failOnError(t, db_manager.UnlinkNoteCategory(tx, 1, "a"))
+
+	if err = db_manager.UnlinkNoteCategory(tx, 1, "b/c"); err == nil {
+		t.Fatal("Failed to refuse removing the note from its only category")
+	}
+}
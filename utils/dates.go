@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Date_layouts lists the date formats accepted on import, tried in order. Day first formats are preferred
+// over month first ones, so 02/01/2006 is read as the 2nd of January
+var Date_layouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+	"02/01/2006 15:04",
+	"02/01/2006",
+	"02-01-2006",
+	"02.01.2006",
+	"2 January 2006",
+	"2 Jan 2006",
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"January 2 2006",
+	"Jan 2 2006",
+}
+
+// Parse_date parses a date written in any of the Date_layouts formats. Dates without a time zone are read as UTC
+func Parse_date(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	for _, layout := range Date_layouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unknown date format: %q", value)
+}
+
+// Timestamp_to_time converts a date stored in the database, as seconds since the unix epoch, to an UTC time
+func Timestamp_to_time(timestamp int64) time.Time {
+	return time.Unix(timestamp, 0).UTC()
+}
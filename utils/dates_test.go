@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateFormats(t *testing.T) {
+	expected := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{"2024-03-05", "2024/03/05", "05/03/2024", "05-03-2024", "05.03.2024", "5 March 2024", "5 Mar 2024", "March 5, 2024", "Mar 5 2024", " 2024-03-05 "} {
+		date, err := Parse_date(value)
+
+		failOnError(t, err)
+
+		FailNotEquals(t, "Failed to parse date "+value, expected, date)
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	date, err := Parse_date("2024-03-05T10:30:00+01:00")
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to normalise date to UTC", time.Date(2024, time.March, 5, 9, 30, 0, 0, time.UTC), date)
+}
+
+func TestParseDateInvalid(t *testing.T) {
+	if _, err := Parse_date("Sample create date"); err == nil {
+		t.Fatal("Failed to reject invalid date")
+	}
+}
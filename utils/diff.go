@@ -0,0 +1,49 @@
+package utils
+
+// Diff_lines returns a line diff from a to b, in which each line is prefixed by "- " if it was removed,
+// "+ " if it was added, or "  " if it is present in both
+func Diff_lines(a []string, b []string) []string {
+	// lcs[i][j] holds the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	diff := make([]string, 0, len(a)+len(b))
+
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+		if a[i] == b[j] {
+			diff = append(diff, "  "+a[i])
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			diff = append(diff, "- "+a[i])
+			i++
+		} else {
+			diff = append(diff, "+ "+b[j])
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		diff = append(diff, "- "+a[i])
+	}
+
+	for ; j < len(b); j++ {
+		diff = append(diff, "+ "+b[j])
+	}
+
+	return diff
+}
@@ -0,0 +1,258 @@
+package utils
+
+import (
+	"cotonetes/types"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// DuplicateGroup holds the notes sharing the same canonical url, ordered by id
+type DuplicateGroup struct {
+	Canonical_url string
+	Notes         []StoredNote
+}
+
+// DuplicateNotes returns the groups of notes whose canonical url matches, ordered by canonical url
+func (d *DatabaseManager) DuplicateNotes(tx *sql.Tx) ([]DuplicateGroup, error) {
+	select_duplicates_stmt := `
+	select canonical_url, id from notes
+	where canonical_url in (select canonical_url from notes group by canonical_url having count(*) > 1)
+	order by canonical_url, id;`
+
+	rows, err := tx.Query(select_duplicates_stmt)
+	if err != nil {
+		return nil, &DatabaseError{select_duplicates_stmt, "", err}
+	}
+
+	groups := make([]DuplicateGroup, 0)
+
+	for rows.Next() {
+		var canonical_url string
+		var note_id int64
+
+		if err = rows.Scan(&canonical_url, &note_id); err != nil {
+			rows.Close()
+			return nil, &DatabaseError{select_duplicates_stmt, "", err}
+		}
+
+		if len(groups) == 0 || groups[len(groups)-1].Canonical_url != canonical_url {
+			groups = append(groups, DuplicateGroup{Canonical_url: canonical_url})
+		}
+
+		group := &groups[len(groups)-1]
+		group.Notes = append(group.Notes, StoredNote{Id: note_id})
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{select_duplicates_stmt, "", err}
+	}
+
+	// the notes are read once the duplicates query is closed, as a transaction can only run one query at a time
+	for _, group := range groups {
+		for index := range group.Notes {
+			if group.Notes[index].Note, err = d.GetNote(tx, group.Notes[index].Id); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return groups, nil
+}
+
+// NoteCategories returns the paths of the categories of the note, ordered by path
+func (d *DatabaseManager) NoteCategories(tx *sql.Tx, note_id int64) ([]string, error) {
+	select_categories_stmt := `select category_paths.path from note_categories inner join category_paths on note_categories.category_id = category_paths.id where note_categories.note_id = $1 order by category_paths.path;`
+
+	rows, err := tx.Query(select_categories_stmt, note_id)
+	if err != nil {
+		return nil, &DatabaseError{select_categories_stmt, "", err}
+	}
+
+	defer rows.Close()
+
+	categories := make([]string, 0)
+
+	for rows.Next() {
+		var path string
+
+		if err = rows.Scan(&path); err != nil {
+			return nil, &DatabaseError{select_categories_stmt, "", err}
+		}
+
+		categories = append(categories, path)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{select_categories_stmt, "", err}
+	}
+
+	return categories, nil
+}
+
+// note_hash returns the hash of the note contents, i.e. of all that is compared to tell whether an imported note
+// changed
+func note_hash(note types.Note) string {
+	hash := sha256.New()
+
+	fmt.Fprintf(hash, "%q\n%q\n%d\n%d\n%q\n%q\n", note.Title, note.Url, note.Created_date.Unix(), note.Updated_date.Unix(), strings.Join(note.Text, "\n"), Normalise_tags(note.Tags))
+
+	for _, path := range slices.Sorted(maps.Keys(note.Images)) {
+		fmt.Fprintf(hash, "image %q %x\n", path, note.Images[path])
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(note.Metadata)) {
+		fmt.Fprintf(hash, "metadata %q %q\n", key, note.Metadata[key])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// addNoteMerge records that a note with the given url and contents was merged into the note
+func (d *DatabaseManager) addNoteMerge(tx *sql.Tx, note_id int64, note types.Note) error {
+	insert_merge_stmt := `insert or ignore into note_merges (note_id, url, hash) values ($1, $2, $3);`
+
+	if _, err := tx.Exec(insert_merge_stmt, note_id, note.Url, note_hash(note)); err != nil {
+		return &DatabaseError{insert_merge_stmt, note.Title, err}
+	}
+
+	return nil
+}
+
+// findMergedNote looks up the note linked to the given category that a note with the given url was merged into.
+// The boolean return value is false if there is no such note
+func (d *DatabaseManager) findMergedNote(tx *sql.Tx, url string, cat_id int64) (int64, bool, error) {
+	var note_id int64
+
+	select_merge_stmt := `select note_merges.note_id from note_merges inner join note_categories on note_merges.note_id = note_categories.note_id where note_merges.url = $1 and note_categories.category_id = $2 order by note_merges.note_id limit 1;`
+
+	err := tx.QueryRow(select_merge_stmt, url, cat_id).Scan(&note_id)
+
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, &DatabaseError{select_merge_stmt, "", err}
+	}
+
+	return note_id, true, nil
+}
+
+// isMergedNote tells whether a note with the same contents as the given note was merged into the note, so that
+// importing the notes that were merged again leaves the merged note as it is
+func (d *DatabaseManager) isMergedNote(tx *sql.Tx, note_id int64, note types.Note) (bool, error) {
+	var count int
+
+	select_merge_stmt := `select count(*) from note_merges where note_id = $1 and hash = $2;`
+
+	if err := tx.QueryRow(select_merge_stmt, note_id, note_hash(note)).Scan(&count); err != nil {
+		return false, &DatabaseError{select_merge_stmt, note.Title, err}
+	}
+
+	return count > 0, nil
+}
+
+// MergeNotes combines the source notes into the target note and deletes them. The target keeps its title and url,
+// the text of each source that differs from the target is appended to it, and the target gets the categories, tags,
+// images and metadata of every source, the earliest created date and the latest updated date. The revisions of the
+// sources are kept as revisions of the target. The contents of the notes before merging are recorded, so importing
+// them again, unchanged, does not undo the merge
+func (d *DatabaseManager) MergeNotes(tx *sql.Tx, target_id int64, source_ids []int64) error {
+	if len(source_ids) == 0 {
+		return errors.New("no notes to merge")
+	}
+
+	if slices.Contains(source_ids, target_id) {
+		return fmt.Errorf("note %d can not be merged into itself", target_id)
+	}
+
+	target, err := d.GetNote(tx, target_id)
+	if err != nil {
+		return err
+	}
+
+	// the notes are recorded as they were before merging, for the import to leave the merged note as it is
+	if err = d.addNoteMerge(tx, target_id, target); err != nil {
+		return err
+	}
+
+	link_categories_stmt := `insert or ignore into note_categories (note_id, category_id) select $1, category_id from note_categories where note_id = $2;`
+	move_revisions_stmt := `update note_revisions set note_id = $1 where note_id = $2;`
+	move_merges_stmt := `insert or ignore into note_merges (note_id, url, hash) select $1, url, hash from note_merges where note_id = $2;`
+
+	for _, source_id := range source_ids {
+		source, err := d.GetNote(tx, source_id)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(source.Text, target.Text) {
+			// keep the texts apart, unless one of them already has a blank line in between
+			if len(target.Text) > 0 && len(source.Text) > 0 && target.Text[len(target.Text)-1] != "" && source.Text[0] != "" {
+				target.Text = append(target.Text, "")
+			}
+
+			target.Text = append(target.Text, source.Text...)
+		}
+
+		if source.Created_date.Before(target.Created_date) {
+			target.Created_date = source.Created_date
+		}
+
+		if source.Updated_date.After(target.Updated_date) {
+			target.Updated_date = source.Updated_date
+		}
+
+		target.Tags = append(target.Tags, source.Tags...)
+
+		// an image path already shown by the target keeps the target image
+		for path, data := range source.Images {
+			if _, found := target.Images[path]; !found {
+				if target.Images == nil {
+					target.Images = make(map[string][]byte)
+				}
+
+				target.Images[path] = data
+			}
+		}
+
+		// as are the metadata keys the target already has
+		for key, value := range source.Metadata {
+			if _, found := target.Metadata[key]; !found {
+				if target.Metadata == nil {
+					target.Metadata = make(map[string]string)
+				}
+
+				target.Metadata[key] = value
+			}
+		}
+
+		if _, err = tx.Exec(link_categories_stmt, target_id, source_id); err != nil {
+			return &DatabaseError{link_categories_stmt, source.Title, err}
+		}
+
+		if _, err = tx.Exec(move_revisions_stmt, target_id, source_id); err != nil {
+			return &DatabaseError{move_revisions_stmt, source.Title, err}
+		}
+
+		if err = d.addNoteMerge(tx, target_id, source); err != nil {
+			return err
+		}
+
+		if _, err = tx.Exec(move_merges_stmt, target_id, source_id); err != nil {
+			return &DatabaseError{move_merges_stmt, source.Title, err}
+		}
+
+		if err = d.DeleteNote(tx, source_id); err != nil {
+			return note_error(err, source)
+		}
+	}
+
+	return d.UpdateNote(tx, target_id, target)
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"cotonetes/types"
+	"testing"
+	"time"
+)
+
+func TestDuplicateNotes(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	note := TdTextOnly.Markdown
+	note.Url = "https://example.com/git/"
+
+	importNote(t, db_manager, db, "a", note)
+
+	note.Url = "http://www.example.com/git?utm_source=feed"
+	note.Text = []string{"Other line"}
+
+	importNote(t, db_manager, db, "b", note)
+
+	note.Url = "https://example.com/docker"
+
+	importNote(t, db_manager, db, "b", note)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	groups, err := db_manager.DuplicateNotes(tx)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to find duplicate groups", 1, len(groups))
+	FailNotEquals(t, "Failed to report canonical url", "https://example.com/git", groups[0].Canonical_url)
+	FailNotEquals(t, "Failed to group duplicate notes", 2, len(groups[0].Notes))
+	FailNotEquals(t, "Failed to keep original url", "https://example.com/git/", groups[0].Notes[0].Note.Url)
+}
+
+func TestMergeNotes(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	target := TdTextOnly.Markdown
+	target.Tags = []string{"git"}
+
+	source := types.Note{
+		Title:        "Other title",
+		Url:          "http://sample.url",
+		Created_date: TdCreatedDate.Add(-24 * time.Hour),
+		Updated_date: TdUpdatedDate.Add(24 * time.Hour),
+		Text:         []string{"Other line"},
+		Tags:         []string{"vcs"},
+	}
+
+	importNote(t, db_manager, db, "a", target)
+	importNote(t, db_manager, db, "b/c", source)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	failOnError(t, db_manager.MergeNotes(tx, 1, []int64{2}))
+
+	merged, err := db_manager.GetNote(tx, 1)
+
+	failOnError(t, err)
+
+	FailNotEqualsStruct(t, "Failed to merge notes", types.Note{
+		Title:        target.Title,
+		Url:          target.Url,
+		Created_date: source.Created_date,
+		Updated_date: source.Updated_date,
+		Text:         []string{"Sample line", "", "Other line"},
+		Tags:         []string{"git", "vcs"},
+	}, merged)
+
+	categories, err := db_manager.NoteCategories(tx, 1)
+
+	failOnError(t, err)
+
+	FailNotEqualsStruct(t, "Failed to merge note categories", []string{"a", "b/c"}, categories)
+
+	if _, err = db_manager.GetNote(tx, 2); err == nil {
+		t.Fatal("Failed to delete merged note")
+	}
+
+	if err = db_manager.MergeNotes(tx, 1, []int64{1}); err == nil {
+		t.Fatal("Failed to refuse merging a note into itself")
+	}
+}
+
+func TestImportMergedNotes(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	target := TdTextOnly.Markdown
+	target.Url = "http://www.example.com/git/"
+
+	source := TdTextOnly.Markdown
+	source.Url = "https://example.com/git"
+	source.Text = []string{"Other line"}
+
+	importNote(t, db_manager, db, "a", target)
+	importNote(t, db_manager, db, "b", source)
+
+	tx := beginTransaction(t, db_manager, db)
+
+	failOnError(t, db_manager.MergeNotes(tx, 1, []int64{2}))
+	failOnError(t, db_manager.CommitTransaction(tx))
+
+	FailNotEquals(t, "Failed to keep merged target note", NoteUnchanged, importNote(t, db_manager, db, "a", target))
+	FailNotEquals(t, "Failed to find merged source note from its url", NoteUnchanged, importNote(t, db_manager, db, "b", source))
+
+	source.Text = []string{"Changed line"}
+
+	FailNotEquals(t, "Failed to update merged note changed since", NoteUpdated, importNote(t, db_manager, db, "b", source))
+
+	tx = beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	groups, err := db_manager.DuplicateNotes(tx)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to update the merged note instead of adding a duplicate", 0, len(groups))
+}
+
+func TestImportSameCanonicalUrl(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	install := TdTextOnly.Markdown
+	install.Url = "https://example.com/guide#install"
+
+	usage := TdTextOnly.Markdown
+	usage.Url = "https://example.com/guide#usage"
+	usage.Text = []string{"Other line"}
+
+	FailNotEquals(t, "Failed to add first note", NoteAdded, importNote(t, db_manager, db, "a", install))
+	FailNotEquals(t, "Failed to add note sharing the canonical url", NoteAdded, importNote(t, db_manager, db, "a", usage))
+
+	FailNotEquals(t, "Failed to keep first note on re-import", NoteUnchanged, importNote(t, db_manager, db, "a", install))
+	FailNotEquals(t, "Failed to keep second note on re-import", NoteUnchanged, importNote(t, db_manager, db, "a", usage))
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	groups, err := db_manager.DuplicateNotes(tx)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to list notes sharing the canonical url as duplicates", 1, len(groups))
+}
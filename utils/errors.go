@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DatabaseError is returned when a database statement fails. Note holds the title of the note being
+// stored when the statement failed, if any
+type DatabaseError struct {
+	Statement string
+	Note      string
+	Err       error
+}
+
+func (e *DatabaseError) Error() string {
+	statement := strings.Join(strings.Fields(e.Statement), " ")
+
+	if e.Note != "" {
+		return fmt.Sprintf("database error on note %q: %v (statement: %s)", e.Note, e.Err, statement)
+	}
+
+	return fmt.Sprintf("database error: %v (statement: %s)", e.Err, statement)
+}
+
+func (e *DatabaseError) Unwrap() error {
+	return e.Err
+}
+
+// SchemaVersionError is returned when the database schema version is not the one expected by this version of cotonetes
+type SchemaVersionError struct {
+	Db_path  string
+	Version  int
+	Expected int
+}
+
+func (e *SchemaVersionError) Error() string {
+	if e.Version > e.Expected || e.Version < 0 {
+		return fmt.Sprintf("database %s has schema version %d, which is unknown to this version of cotonetes (latest known version is %d)", e.Db_path, e.Version, e.Expected)
+	}
+
+	return fmt.Sprintf("database %s has schema version %d, but version %d is required. Please run the migrate command first", e.Db_path, e.Version, e.Expected)
+}
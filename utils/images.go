@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"bytes"
+	"database/sql"
+	"maps"
+)
+
+// Images_equal returns whether both notes hold the same image files
+func Images_equal(a map[string][]byte, b map[string][]byte) bool {
+	return maps.EqualFunc(a, b, bytes.Equal)
+}
+
+func (d *DatabaseManager) setNoteImages(tx *sql.Tx, note_id int64, images map[string][]byte) error {
+	delete_note_images_stmt := `delete from note_images where note_id = $1;`
+
+	if _, err := tx.Exec(delete_note_images_stmt, note_id); err != nil {
+		return &DatabaseError{delete_note_images_stmt, "", err}
+	}
+
+	insert_note_image_stmt := `insert into note_images (note_id, path, data) values ($1, $2, $3);`
+
+	for path, data := range images {
+		if _, err := tx.Exec(insert_note_image_stmt, note_id, path, data); err != nil {
+			return &DatabaseError{insert_note_image_stmt, "", err}
+		}
+	}
+
+	return nil
+}
+
+func (d *DatabaseManager) noteImages(tx *sql.Tx, note_id int64) (map[string][]byte, error) {
+	select_note_images_stmt := `select path, data from note_images where note_id = $1;`
+
+	rows, err := tx.Query(select_note_images_stmt, note_id)
+	if err != nil {
+		return nil, &DatabaseError{select_note_images_stmt, "", err}
+	}
+
+	defer rows.Close()
+
+	var images map[string][]byte
+
+	for rows.Next() {
+		var path string
+		var data []byte
+
+		if err = rows.Scan(&path, &data); err != nil {
+			return nil, &DatabaseError{select_note_images_stmt, "", err}
+		}
+
+		if images == nil {
+			images = make(map[string][]byte)
+		}
+
+		images[path] = data
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{select_note_images_stmt, "", err}
+	}
+
+	return images, nil
+}
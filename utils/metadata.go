@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"database/sql"
+	"maps"
+	"slices"
+)
+
+// Metadata_keys are the declared note metadata keys, in the order they are written. Other keys are kept as well,
+// written after these ones in alphabetical order
+var Metadata_keys = []string{"Author", "Source", "Status", "Rating"}
+
+// Metadata_order returns the keys of the note metadata in the order they are written
+func Metadata_order(metadata map[string]string) []string {
+	keys := make([]string, 0, len(metadata))
+
+	for _, key := range Metadata_keys {
+		if _, found := metadata[key]; found {
+			keys = append(keys, key)
+		}
+	}
+
+	undeclared := make([]string, 0)
+
+	for key := range metadata {
+		if !slices.Contains(Metadata_keys, key) {
+			undeclared = append(undeclared, key)
+		}
+	}
+
+	slices.Sort(undeclared)
+
+	return append(keys, undeclared...)
+}
+
+// Metadata_equal returns whether both notes hold the same metadata
+func Metadata_equal(a map[string]string, b map[string]string) bool {
+	return maps.Equal(a, b)
+}
+
+func (d *DatabaseManager) setNoteMetadata(tx *sql.Tx, note_id int64, metadata map[string]string) error {
+	delete_note_metadata_stmt := `delete from note_metadata where note_id = $1;`
+
+	if _, err := tx.Exec(delete_note_metadata_stmt, note_id); err != nil {
+		return &DatabaseError{delete_note_metadata_stmt, "", err}
+	}
+
+	insert_note_metadata_stmt := `insert into note_metadata (note_id, key, value) values ($1, $2, $3);`
+
+	for key, value := range metadata {
+		if _, err := tx.Exec(insert_note_metadata_stmt, note_id, key, value); err != nil {
+			return &DatabaseError{insert_note_metadata_stmt, "", err}
+		}
+	}
+
+	return nil
+}
+
+func (d *DatabaseManager) noteMetadata(tx *sql.Tx, note_id int64) (map[string]string, error) {
+	select_note_metadata_stmt := `select key, value from note_metadata where note_id = $1;`
+
+	rows, err := tx.Query(select_note_metadata_stmt, note_id)
+	if err != nil {
+		return nil, &DatabaseError{select_note_metadata_stmt, "", err}
+	}
+
+	defer rows.Close()
+
+	var metadata map[string]string
+
+	for rows.Next() {
+		var key, value string
+
+		if err = rows.Scan(&key, &value); err != nil {
+			return nil, &DatabaseError{select_note_metadata_stmt, "", err}
+		}
+
+		if metadata == nil {
+			metadata = make(map[string]string)
+		}
+
+		metadata[key] = value
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{select_note_metadata_stmt, "", err}
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,490 @@
+package utils
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type migration struct {
+	Version     int
+	Description string
+	Statement   string
+	// Apply runs after Statement, for changes that can not be written in SQL alone
+	Apply func(tx *sql.Tx) error
+}
+
+// migrations must be kept in ascending version order. Once released, a migration must never be changed,
+// new schema changes are always added as a new migration at the end of the list
+var migrations = []migration{
+	{
+		1,
+		"Create categories, notes and note_categories tables",
+		`
+		create table if not exists categories (id INTEGER PRIMARY KEY, category TEXT UNIQUE NOT NULL);
+		create table if not exists notes (id INTEGER PRIMARY KEY, title TEXT NOT NULL, url TEXT NOT NULL, created INTEGER NOT NULL, last_updated INTEGER NOT NULL, note TEXT NOT NULL);
+		create table if not exists note_categories (note_id INTEGER, category_id INTEGER, FOREIGN KEY(note_id) REFERENCES notes(id), FOREIGN KEY(category_id) REFERENCES categories(id), PRIMARY KEY(note_id, category_id));
+		`,
+		nil,
+	},
+	{
+		2,
+		"Create notes_fts full-text index over note title, url and text",
+		`
+		create virtual table notes_fts using fts5(title, url, note, content='notes', content_rowid='id');
+		create trigger notes_fts_insert after insert on notes begin
+			insert into notes_fts (rowid, title, url, note) values (new.id, new.title, new.url, new.note);
+		end;
+		create trigger notes_fts_delete after delete on notes begin
+			insert into notes_fts (notes_fts, rowid, title, url, note) values ('delete', old.id, old.title, old.url, old.note);
+		end;
+		create trigger notes_fts_update after update on notes begin
+			insert into notes_fts (notes_fts, rowid, title, url, note) values ('delete', old.id, old.title, old.url, old.note);
+			insert into notes_fts (rowid, title, url, note) values (new.id, new.title, new.url, new.note);
+		end;
+		insert into notes_fts (notes_fts) values ('rebuild');
+		`,
+		nil,
+	},
+	{
+		3,
+		"Convert the note created and last_updated dates from text to unix timestamps",
+		``,
+		migrate_dates_to_timestamps,
+	},
+	{
+		4,
+		"Create note_revisions table, filled with the previous note contents whenever a note changes",
+		`
+		create table note_revisions (id INTEGER PRIMARY KEY, note_id INTEGER NOT NULL, title TEXT NOT NULL, url TEXT NOT NULL, created INTEGER NOT NULL, last_updated INTEGER NOT NULL, note TEXT NOT NULL, revised INTEGER NOT NULL, FOREIGN KEY(note_id) REFERENCES notes(id));
+		create index note_revisions_note_id on note_revisions (note_id);
+		create trigger notes_revision before update on notes
+		when old.title is not new.title or old.url is not new.url or old.created is not new.created or old.last_updated is not new.last_updated or old.note is not new.note
+		begin
+			insert into note_revisions (note_id, title, url, created, last_updated, note, revised) values (old.id, old.title, old.url, old.created, old.last_updated, old.note, unixepoch());
+		end;
+		`,
+		nil,
+	},
+	{
+		5,
+		"Replace the flat category paths by a tree of categories linked to their parent",
+		`
+		create table categories_tree (id INTEGER PRIMARY KEY, name TEXT NOT NULL, parent_id INTEGER, FOREIGN KEY(parent_id) REFERENCES categories_tree(id));
+		`,
+		migrate_categories_to_tree,
+	},
+	{
+		6,
+		"Create tags and note_tags tables",
+		`
+		create table tags (id INTEGER PRIMARY KEY, name TEXT UNIQUE NOT NULL);
+		create table note_tags (note_id INTEGER, tag_id INTEGER, FOREIGN KEY(note_id) REFERENCES notes(id), FOREIGN KEY(tag_id) REFERENCES tags(id), PRIMARY KEY(note_id, tag_id));
+		`,
+		nil,
+	},
+	{
+		7,
+		"Add the canonical note url, used to find notes saved from the same page",
+		`
+		alter table notes add column canonical_url TEXT NOT NULL DEFAULT '';
+		create index notes_canonical_url on notes (canonical_url);
+		`,
+		migrate_canonical_urls,
+	},
+	{
+		8,
+		"Create note_images table, holding the image files shown in the note texts",
+		`
+		create table note_images (note_id INTEGER NOT NULL, path TEXT NOT NULL, data BLOB NOT NULL, FOREIGN KEY(note_id) REFERENCES notes(id), PRIMARY KEY(note_id, path));
+		`,
+		nil,
+	},
+	{
+		9,
+		"Create note_metadata table, holding the note metadata other than the title, url, dates and tags",
+		`
+		create table note_metadata (note_id INTEGER NOT NULL, key TEXT NOT NULL, value TEXT NOT NULL, FOREIGN KEY(note_id) REFERENCES notes(id), PRIMARY KEY(note_id, key));
+		`,
+		nil,
+	},
+	{
+		10,
+		"Create note_provenance table, holding the file and lines each note was last imported from",
+		`
+		create table note_provenance (note_id INTEGER PRIMARY KEY, file_path TEXT NOT NULL, start_line INTEGER NOT NULL, end_line INTEGER NOT NULL, hash TEXT NOT NULL, FOREIGN KEY(note_id) REFERENCES notes(id));
+		`,
+		nil,
+	},
+	{
+		11,
+		"Create note_merges table, holding the url and the hash of the contents of each note merged into another",
+		`
+		create table note_merges (note_id INTEGER NOT NULL, url TEXT NOT NULL, hash TEXT NOT NULL, FOREIGN KEY(note_id) REFERENCES notes(id), PRIMARY KEY(note_id, hash));
+		`,
+		nil,
+	},
+}
+
+// migrate_dates_to_timestamps converts the dates stored as text by older versions, failing with the list of
+// notes whose dates can not be parsed
+func migrate_dates_to_timestamps(tx *sql.Tx) error {
+	type note_dates struct {
+		id      int64
+		title   string
+		created string
+		updated string
+	}
+
+	select_dates_stmt := `select id, title, created, last_updated from notes where typeof(created) = 'text' or typeof(last_updated) = 'text';`
+
+	rows, err := tx.Query(select_dates_stmt)
+	if err != nil {
+		return &DatabaseError{select_dates_stmt, "", err}
+	}
+
+	notes := make([]note_dates, 0)
+
+	for rows.Next() {
+		var n note_dates
+
+		if err = rows.Scan(&n.id, &n.title, &n.created, &n.updated); err != nil {
+			rows.Close()
+			return &DatabaseError{select_dates_stmt, "", err}
+		}
+
+		notes = append(notes, n)
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return &DatabaseError{select_dates_stmt, "", err}
+	}
+
+	update_dates_stmt := `update notes set created = $1, last_updated = $2 where id = $3;`
+
+	date_errors := make([]error, 0)
+
+	for _, n := range notes {
+		created, created_err := parse_stored_date(n.created)
+		updated, updated_err := parse_stored_date(n.updated)
+
+		if created_err != nil || updated_err != nil {
+			date_errors = append(date_errors, fmt.Errorf("note %d %q: %w", n.id, n.title, errors.Join(created_err, updated_err)))
+			continue
+		}
+
+		if _, err = tx.Exec(update_dates_stmt, created, updated, n.id); err != nil {
+			return &DatabaseError{update_dates_stmt, n.title, err}
+		}
+	}
+
+	return errors.Join(date_errors...)
+}
+
+// migrate_categories_to_tree fills the categories_tree table from the flat category paths, keeping the category
+// ids so the notes stay linked to the same categories, and replaces the categories table with it
+func migrate_categories_to_tree(tx *sql.Tx) error {
+	select_categories_stmt := `select id, category from categories;`
+
+	rows, err := tx.Query(select_categories_stmt)
+	if err != nil {
+		return &DatabaseError{select_categories_stmt, "", err}
+	}
+
+	category_ids := make(map[string]int64)
+
+	for rows.Next() {
+		var id int64
+		var category string
+
+		if err = rows.Scan(&id, &category); err != nil {
+			rows.Close()
+			return &DatabaseError{select_categories_stmt, "", err}
+		}
+
+		// older versions stored the paths using the OS path separator
+		category_ids[strings.Join(strings.Split(category, string(os.PathSeparator)), Category_separator)] = id
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return &DatabaseError{select_categories_stmt, "", err}
+	}
+
+	insert_category_stmt := `insert into categories_tree (id, name, parent_id) values ($1, $2, null);`
+
+	// every existing category is inserted first, so the ids of the missing ancestor categories created below
+	// do not clash with any existing id
+	for path, id := range category_ids {
+		if _, err = tx.Exec(insert_category_stmt, id, category_name(path)); err != nil {
+			return &DatabaseError{insert_category_stmt, "", err}
+		}
+	}
+
+	insert_ancestor_stmt := `insert into categories_tree (name, parent_id) values ($1, $2);`
+
+	var ensure_category func(path string) (sql.NullInt64, error)
+
+	ensure_category = func(path string) (sql.NullInt64, error) {
+		if path == "" {
+			return sql.NullInt64{}, nil
+		}
+
+		if id, found := category_ids[path]; found {
+			return sql.NullInt64{Int64: id, Valid: true}, nil
+		}
+
+		parent_id, err := ensure_category(category_parent_path(path))
+		if err != nil {
+			return parent_id, err
+		}
+
+		res, err := tx.Exec(insert_ancestor_stmt, category_name(path), parent_id)
+		if err != nil {
+			return parent_id, &DatabaseError{insert_ancestor_stmt, "", err}
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return parent_id, &DatabaseError{insert_ancestor_stmt, "", err}
+		}
+
+		category_ids[path] = id
+
+		return sql.NullInt64{Int64: id, Valid: true}, nil
+	}
+
+	update_parent_stmt := `update categories_tree set parent_id = $1 where id = $2;`
+
+	for _, path := range slices.Collect(maps.Keys(category_ids)) {
+		parent_id, err := ensure_category(category_parent_path(path))
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.Exec(update_parent_stmt, parent_id, category_ids[path]); err != nil {
+			return &DatabaseError{update_parent_stmt, "", err}
+		}
+	}
+
+	replace_table_stmt := `
+	drop table categories;
+	alter table categories_tree rename to categories;
+	create unique index categories_parent_name on categories (ifnull(parent_id, 0), name);
+	create view category_paths (id, path, depth) as
+	with recursive paths (id, path, depth) as (
+		select id, name, 0 from categories where parent_id is null
+		union all
+		select categories.id, paths.path || '` + Category_separator + `' || categories.name, paths.depth + 1 from categories inner join paths on categories.parent_id = paths.id
+	)
+	select id, path, depth from paths;
+	`
+
+	if _, err = tx.Exec(replace_table_stmt); err != nil {
+		return &DatabaseError{replace_table_stmt, "", err}
+	}
+
+	return nil
+}
+
+// migrate_canonical_urls fills the canonical url of the notes stored before it was kept
+func migrate_canonical_urls(tx *sql.Tx) error {
+	select_urls_stmt := `select id, url from notes;`
+
+	rows, err := tx.Query(select_urls_stmt)
+	if err != nil {
+		return &DatabaseError{select_urls_stmt, "", err}
+	}
+
+	urls := make(map[int64]string)
+
+	for rows.Next() {
+		var note_id int64
+		var note_url string
+
+		if err = rows.Scan(&note_id, &note_url); err != nil {
+			rows.Close()
+			return &DatabaseError{select_urls_stmt, "", err}
+		}
+
+		urls[note_id] = note_url
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return &DatabaseError{select_urls_stmt, "", err}
+	}
+
+	update_url_stmt := `update notes set canonical_url = $1 where id = $2;`
+
+	for note_id, note_url := range urls {
+		if _, err = tx.Exec(update_url_stmt, Canonical_url(note_url), note_id); err != nil {
+			return &DatabaseError{update_url_stmt, "", err}
+		}
+	}
+
+	return nil
+}
+
+// parse_stored_date returns the unix timestamp of a date stored as text, which may already hold a timestamp
+func parse_stored_date(value string) (int64, error) {
+	if timestamp, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return timestamp, nil
+	}
+
+	date, err := Parse_date(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return date.Unix(), nil
+}
+
+// ErrFts5Missing is returned when the sqlite library lacks the FTS5 extension, which the full-text index of the notes
+// requires. The go-sqlite3 driver only holds it when built with the sqlite_fts5 tag
+var ErrFts5Missing = errors.New("the sqlite FTS5 extension is missing, build with -tags sqlite_fts5")
+
+// Fts5Available tells whether the sqlite library the database is opened with holds the FTS5 extension
+func (d *DatabaseManager) Fts5Available(tx *sql.Tx) (bool, error) {
+	var available bool
+
+	select_option_stmt := `select sqlite_compileoption_used('ENABLE_FTS5');`
+
+	if err := tx.QueryRow(select_option_stmt).Scan(&available); err != nil {
+		return false, &DatabaseError{select_option_stmt, "", err}
+	}
+
+	return available, nil
+}
+
+// checkFts5 returns ErrFts5Missing if the sqlite library lacks the FTS5 extension, as the notes can then neither be
+// indexed nor changed, the index being updated by triggers
+func (d *DatabaseManager) checkFts5(tx *sql.Tx) error {
+	if available, err := d.Fts5Available(tx); err != nil {
+		return err
+	} else if !available {
+		return ErrFts5Missing
+	}
+
+	return nil
+}
+
+func LatestSchemaVersion() int {
+	return migrations[len(migrations)-1].Version
+}
+
+func (d *DatabaseManager) tableExists(tx *sql.Tx, table string) (bool, error) {
+	var count int
+
+	select_table_stmt := `select count(*) from sqlite_master where type = 'table' and name = $1;`
+
+	if err := tx.QueryRow(select_table_stmt, table).Scan(&count); err != nil {
+		return false, &DatabaseError{select_table_stmt, "", err}
+	}
+
+	return count > 0, nil
+}
+
+// SchemaVersion returns the schema version of the database, 0 if the database is empty.
+// Databases created before the schema was versioned have no schema_version table and are reported as version 1
+func (d *DatabaseManager) SchemaVersion(tx *sql.Tx) (int, error) {
+	if exists, err := d.tableExists(tx, "schema_version"); err != nil {
+		return 0, err
+	} else if !exists {
+		if exists, err = d.tableExists(tx, "notes"); err != nil {
+			return 0, err
+		} else if exists {
+			return 1, nil
+		}
+		return 0, nil
+	}
+
+	var version int
+
+	select_version_stmt := `select version from schema_version;`
+
+	if err := tx.QueryRow(select_version_stmt).Scan(&version); err != nil {
+		return 0, &DatabaseError{select_version_stmt, "", err}
+	}
+
+	return version, nil
+}
+
+func (d *DatabaseManager) setSchemaVersion(tx *sql.Tx, version int) error {
+	set_version_stmt := `
+	create table if not exists schema_version (version INTEGER NOT NULL);
+	delete from schema_version;
+	insert into schema_version (version) values ($1);
+	`
+
+	if _, err := tx.Exec(set_version_stmt, version); err != nil {
+		return &DatabaseError{set_version_stmt, "", err}
+	}
+
+	return nil
+}
+
+// CheckSchemaVersion returns a SchemaVersionError if the database schema is not at the latest version, and
+// ErrFts5Missing if the sqlite library can not use its full-text index
+func (d *DatabaseManager) CheckSchemaVersion(tx *sql.Tx) error {
+	version, err := d.SchemaVersion(tx)
+	if err != nil {
+		return err
+	}
+
+	if version != LatestSchemaVersion() {
+		return &SchemaVersionError{d.Db_path, version, LatestSchemaVersion()}
+	}
+
+	return d.checkFts5(tx)
+}
+
+// Migrate applies, in order, every migration newer than the current database schema version.
+// It returns the schema version before and after the migration
+func (d *DatabaseManager) Migrate(tx *sql.Tx) (int, int, error) {
+	from_version, err := d.SchemaVersion(tx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if from_version < 0 || from_version > LatestSchemaVersion() {
+		return from_version, from_version, &SchemaVersionError{d.Db_path, from_version, LatestSchemaVersion()}
+	}
+
+	if err = d.checkFts5(tx); err != nil {
+		return from_version, from_version, err
+	}
+
+	for _, m := range migrations {
+		if m.Version <= from_version {
+			continue
+		}
+
+		if m.Statement != "" {
+			if _, err := tx.Exec(m.Statement); err != nil {
+				return from_version, from_version, &DatabaseError{m.Statement, "", fmt.Errorf("migration %d (%s) failed: %w", m.Version, m.Description, err)}
+			}
+		}
+
+		if m.Apply != nil {
+			if err := m.Apply(tx); err != nil {
+				return from_version, from_version, fmt.Errorf("migration %d (%s) failed: %w", m.Version, m.Description, err)
+			}
+		}
+	}
+
+	if err = d.setSchemaVersion(tx, LatestSchemaVersion()); err != nil {
+		return from_version, from_version, err
+	}
+
+	return from_version, LatestSchemaVersion(), nil
+}
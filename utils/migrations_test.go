@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrateEmptyDatabase(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	version, err := db_manager.SchemaVersion(tx)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to create database at latest schema version", LatestSchemaVersion(), version)
+}
+
+func TestMigrateUnversionedDatabase(t *testing.T) {
+	db_manager := DatabaseManager{filepath.Join(t.TempDir(), "cotonetes.db")}
+
+	db, err := db_manager.OpenDatabase()
+
+	failOnError(t, err)
+
+	defer db.Close()
+
+	skipWithoutFts5(t, db_manager, db)
+
+	// databases created before schema versioning only have the tables of the first migration
+	if _, err := db.Exec(migrations[0].Statement); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := beginTransaction(t, db_manager, db)
+
+	version, err := db_manager.SchemaVersion(tx)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to detect unversioned database", 1, version)
+
+	from_version, to_version, err := db_manager.Migrate(tx)
+
+	failOnError(t, err)
+	failOnError(t, db_manager.CommitTransaction(tx))
+
+	FailNotEquals(t, "Failed to report version before migration", 1, from_version)
+	FailNotEquals(t, "Failed to report version after migration", LatestSchemaVersion(), to_version)
+
+	tx = beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	version, err = db_manager.SchemaVersion(tx)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to store migrated schema version", LatestSchemaVersion(), version)
+}
+
+func TestNewerSchemaVersion(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	failOnError(t, db_manager.setSchemaVersion(tx, LatestSchemaVersion()+1))
+
+	var version_err *SchemaVersionError
+
+	FailNotEquals(t, "Failed to reject newer schema version", true, errors.As(db_manager.CheckSchemaVersion(tx), &version_err))
+
+	_, _, err := db_manager.Migrate(tx)
+
+	FailNotEquals(t, "Failed to refuse migrating newer schema version", true, errors.As(err, &version_err))
+}
+
+func TestMigrateTextDates(t *testing.T) {
+	db_manager := DatabaseManager{filepath.Join(t.TempDir(), "cotonetes.db")}
+
+	db, err := db_manager.OpenDatabase()
+
+	failOnError(t, err)
+
+	defer db.Close()
+
+	skipWithoutFts5(t, db_manager, db)
+
+	// older versions stored the dates as written in the latex notes
+	if _, err := db.Exec(migrations[0].Statement); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`insert into notes (title, url, created, last_updated, note) values ('title', 'url', '2024-03-05', '20/11/2024', 'text');`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := beginTransaction(t, db_manager, db)
+
+	_, _, err = db_manager.Migrate(tx)
+
+	failOnError(t, err)
+	failOnError(t, db_manager.CommitTransaction(tx))
+
+	tx = beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	var created, updated int64
+
+	if err := tx.QueryRow(`select created, last_updated from notes;`).Scan(&created, &updated); err != nil {
+		t.Fatal(err)
+	}
+
+	FailNotEquals(t, "Failed to convert created date", TdCreatedDate, Timestamp_to_time(created))
+	FailNotEquals(t, "Failed to convert last updated date", TdUpdatedDate, Timestamp_to_time(updated))
+}
+
+func TestMigrateInvalidTextDates(t *testing.T) {
+	db_manager := DatabaseManager{filepath.Join(t.TempDir(), "cotonetes.db")}
+
+	db, err := db_manager.OpenDatabase()
+
+	failOnError(t, err)
+
+	defer db.Close()
+
+	skipWithoutFts5(t, db_manager, db)
+
+	if _, err := db.Exec(migrations[0].Statement); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`insert into notes (title, url, created, last_updated, note) values ('title', 'url', 'yesterday', '2024-03-05', 'text');`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	if _, _, err = db_manager.Migrate(tx); err == nil {
+		t.Fatal("Failed to report date that can not be parsed")
+	}
+}
+
+func TestMigrateCanonicalUrls(t *testing.T) {
+	db_manager := DatabaseManager{filepath.Join(t.TempDir(), "cotonetes.db")}
+
+	db, err := db_manager.OpenDatabase()
+
+	failOnError(t, err)
+
+	defer db.Close()
+
+	skipWithoutFts5(t, db_manager, db)
+
+	if _, err := db.Exec(migrations[0].Statement); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`insert into notes (title, url, created, last_updated, note) values ('title', 'http://www.example.com/git/', '2024-03-05', '2024-11-20', 'text');`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	_, _, err = db_manager.Migrate(tx)
+
+	failOnError(t, err)
+
+	var canonical_url string
+
+	if err := tx.QueryRow(`select canonical_url from notes;`).Scan(&canonical_url); err != nil {
+		t.Fatal(err)
+	}
+
+	FailNotEquals(t, "Failed to fill canonical url", "https://example.com/git", canonical_url)
+}
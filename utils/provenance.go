@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"cotonetes/types"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Read_lines returns the lines of a file as written, line endings left out
+func Read_lines(file_path string) ([]string, error) {
+	data, err := os.ReadFile(file_path)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n"), nil
+}
+
+// Source_hash returns the hash of the lines a note was imported from
+func Source_hash(lines []string) string {
+	hash := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// Provenance_lines returns the lines of the file a note was imported from, from its start line to its end line
+// as they are written now
+func Provenance_lines(provenance types.Provenance) ([]string, error) {
+	lines, err := Read_lines(provenance.File_path)
+	if err != nil {
+		return nil, err
+	}
+
+	if provenance.Start_line < 1 || provenance.Start_line > provenance.End_line || provenance.End_line > len(lines) {
+		return nil, fmt.Errorf("%s has no lines %d to %d", provenance.File_path, provenance.Start_line, provenance.End_line)
+	}
+
+	return lines[provenance.Start_line-1 : provenance.End_line], nil
+}
+
+// setNoteProvenance records where the note was imported from, keeping the provenance already recorded if the note
+// was not imported, e.g. when restoring a revision
+func (d *DatabaseManager) setNoteProvenance(tx *sql.Tx, note_id int64, provenance types.Provenance) error {
+	if provenance.File_path == "" {
+		return nil
+	}
+
+	set_note_provenance_stmt := `insert or replace into note_provenance (note_id, file_path, start_line, end_line, hash) values ($1, $2, $3, $4, $5);`
+
+	if _, err := tx.Exec(set_note_provenance_stmt, note_id, provenance.File_path, provenance.Start_line, provenance.End_line, provenance.Hash); err != nil {
+		return &DatabaseError{set_note_provenance_stmt, "", err}
+	}
+
+	return nil
+}
+
+func (d *DatabaseManager) noteProvenance(tx *sql.Tx, note_id int64) (types.Provenance, error) {
+	var provenance types.Provenance
+
+	select_note_provenance_stmt := `select file_path, start_line, end_line, hash from note_provenance where note_id = $1;`
+
+	err := tx.QueryRow(select_note_provenance_stmt, note_id).Scan(&provenance.File_path, &provenance.Start_line, &provenance.End_line, &provenance.Hash)
+
+	if err != nil && err != sql.ErrNoRows {
+		return provenance, &DatabaseError{select_note_provenance_stmt, "", err}
+	}
+
+	return provenance, nil
+}
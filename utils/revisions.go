@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"cotonetes/types"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// Revision is a previous version of a note, saved when the note was changed at the Revised time
+type Revision struct {
+	Id      int64
+	Note_id int64
+	Note    types.Note
+	Revised time.Time
+}
+
+func scan_revision(scan func(dest ...any) error) (Revision, error) {
+	var revision Revision
+	var created, updated, revised int64
+	var text string
+
+	if err := scan(&revision.Id, &revision.Note_id, &revision.Note.Title, &revision.Note.Url, &created, &updated, &text, &revised); err != nil {
+		return revision, err
+	}
+
+	revision.Note.Created_date = Timestamp_to_time(created)
+	revision.Note.Updated_date = Timestamp_to_time(updated)
+	revision.Note.Text = strings.Split(text, "\n")
+	revision.Revised = Timestamp_to_time(revised)
+
+	return revision, nil
+}
+
+// NoteRevisions returns the previous versions of a note, oldest first
+func (d *DatabaseManager) NoteRevisions(tx *sql.Tx, note_id int64) ([]Revision, error) {
+	select_revisions_stmt := `select id, note_id, title, url, created, last_updated, note, revised from note_revisions where note_id = $1 order by id;`
+
+	rows, err := tx.Query(select_revisions_stmt, note_id)
+	if err != nil {
+		return nil, &DatabaseError{select_revisions_stmt, "", err}
+	}
+
+	defer rows.Close()
+
+	revisions := make([]Revision, 0)
+
+	for rows.Next() {
+		revision, err := scan_revision(rows.Scan)
+		if err != nil {
+			return nil, &DatabaseError{select_revisions_stmt, "", err}
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{select_revisions_stmt, "", err}
+	}
+
+	return revisions, nil
+}
+
+// GetRevision returns the revision with the given id, failing with sql.ErrNoRows if there is no such revision
+func (d *DatabaseManager) GetRevision(tx *sql.Tx, revision_id int64) (Revision, error) {
+	select_revision_stmt := `select id, note_id, title, url, created, last_updated, note, revised from note_revisions where id = $1;`
+
+	revision, err := scan_revision(tx.QueryRow(select_revision_stmt, revision_id).Scan)
+	if err != nil {
+		return revision, &DatabaseError{select_revision_stmt, "", err}
+	}
+
+	return revision, nil
+}
+
+// RestoreRevision replaces the contents of a note with one of its revisions. The replaced contents are
+// themselves saved as a new revision, so a restore can be undone. Revisions do not hold tags, images or metadata, so
+// the note keeps its own
+func (d *DatabaseManager) RestoreRevision(tx *sql.Tx, revision_id int64) error {
+	revision, err := d.GetRevision(tx, revision_id)
+	if err != nil {
+		return err
+	}
+
+	if revision.Note.Tags, err = d.noteTags(tx, revision.Note_id); err != nil {
+		return err
+	}
+
+	if revision.Note.Images, err = d.noteImages(tx, revision.Note_id); err != nil {
+		return err
+	}
+
+	if revision.Note.Metadata, err = d.noteMetadata(tx, revision.Note_id); err != nil {
+		return err
+	}
+
+	return d.UpdateNote(tx, revision.Note_id, revision.Note)
+}
+
+// Note_to_lines renders the whole note, metadata included, as lines of text to be compared by Diff_lines
+func Note_to_lines(note types.Note, date_layout string) []string {
+	lines := []string{
+		"Title: " + note.Title,
+		"URL: " + note.Url,
+		"Created: " + note.Created_date.Format(date_layout),
+		"Last Updated: " + note.Updated_date.Format(date_layout),
+		"",
+	}
+
+	return append(lines, note.Text...)
+}
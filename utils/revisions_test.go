@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestNoteRevisions(t *testing.T) {
+	db_manager, db := setupDatabase(t)
+
+	original := TdTextOnly.Markdown
+
+	importNote(t, db_manager, db, "a", original)
+
+	changed := original
+	changed.Text = []string{"Changed line"}
+
+	importNote(t, db_manager, db, "a", changed)
+
+	// unchanged notes do not create revisions
+	importNote(t, db_manager, db, "a", changed)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	note_id, _, _, err := db_manager.FindNote(tx, original.Url, createCategory(t, db_manager, tx, "a"))
+
+	failOnError(t, err)
+
+	revisions, err := db_manager.NoteRevisions(tx, note_id)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to save expected number of revisions", 1, len(revisions))
+	FailNotEqualsStruct(t, "Failed to save previous note contents", original, revisions[0].Note)
+
+	failOnError(t, db_manager.RestoreRevision(tx, revisions[0].Id))
+
+	restored, err := db_manager.GetNote(tx, note_id)
+
+	failOnError(t, err)
+
+	FailNotEqualsStruct(t, "Failed to restore revision", original, restored)
+
+	revisions, err = db_manager.NoteRevisions(tx, note_id)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to save restored over contents as a revision", 2, len(revisions))
+	FailNotEqualsStruct(t, "Failed to save contents replaced by restore", changed, revisions[1].Note)
+}
+
+func TestDiffLines(t *testing.T) {
+	diff := Diff_lines([]string{"a", "b", "c", "d"}, []string{"a", "c", "e", "d", "f"})
+
+	FailNotEqualsStruct(t, "Failed to diff lines", []string{"  a", "- b", "  c", "+ e", "  d", "+ f"}, diff)
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"database/sql"
+	"strings"
+)
+
+type SearchResult struct {
+	Note_id    int64
+	Title      string
+	Url        string
+	Snippet    string
+	Categories []string
+	Tags       []string
+}
+
+// SearchNotes runs a full-text search over the note titles, urls and texts, returning the best ranked
+// matches first. The query uses the FTS5 syntax, so "quoted phrases" and prefix* terms are supported.
+// If category is not empty, only notes in that category or in any of its sub-categories are returned.
+// If tag is not empty, only notes with that tag are returned
+func (d *DatabaseManager) SearchNotes(tx *sql.Tx, query string, category string, tag string, limit int) ([]SearchResult, error) {
+	search_stmt := `
+	select notes_fts.rowid, notes.title, notes.url, snippet(notes_fts, -1, '**', '**', '...', 16),
+		(select group_concat(category_paths.path, $1) from note_categories inner join category_paths on note_categories.category_id = category_paths.id where note_categories.note_id = notes_fts.rowid),
+		(select group_concat(tags.name, $1) from note_tags inner join tags on note_tags.tag_id = tags.id where note_tags.note_id = notes_fts.rowid)
+	from notes_fts inner join notes on notes.id = notes_fts.rowid
+	where notes_fts match $2
+		and ($3 = '' or exists (
+			select 1 from note_categories inner join category_paths on note_categories.category_id = category_paths.id
+			where note_categories.note_id = notes_fts.rowid
+				and (category_paths.path = $3 or substr(category_paths.path, 1, length($4)) = $4)))
+		and ($5 = '' or exists (
+			select 1 from note_tags inner join tags on note_tags.tag_id = tags.id
+			where note_tags.note_id = notes_fts.rowid and tags.name = $5))
+	order by bm25(notes_fts)
+	limit $6;`
+
+	// ASCII unit separator, which does not show up in category paths or tags, so it is safe to split the concatenated values on it
+	categories_separator := "\x1f"
+
+	rows, err := tx.Query(search_stmt, categories_separator, query, category, category+Category_separator, strings.TrimSpace(tag), limit)
+
+	if err != nil {
+		return nil, &DatabaseError{search_stmt, "", err}
+	}
+
+	defer rows.Close()
+
+	results := make([]SearchResult, 0)
+
+	for rows.Next() {
+		var result SearchResult
+		var categories, tags sql.NullString
+
+		if err = rows.Scan(&result.Note_id, &result.Title, &result.Url, &result.Snippet, &categories, &tags); err != nil {
+			return nil, &DatabaseError{search_stmt, "", err}
+		}
+
+		if categories.Valid {
+			result.Categories = strings.Split(categories.String, categories_separator)
+		}
+
+		if tags.Valid {
+			result.Tags = Normalise_tags(strings.Split(tags.String, categories_separator))
+		}
+
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{search_stmt, "", err}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"cotonetes/types"
+	"database/sql"
+	"testing"
+)
+
+func setupSearch(t *testing.T) (DatabaseManager, *sql.DB) {
+	db_manager, db := setupDatabase(t)
+
+	notes := map[string]types.Note{
+		"tools/docker": types.Note{Title: "Docker multi stage builds", Url: "https://docker.example/builds", Created_date: TdCreatedDate, Updated_date: TdUpdatedDate, Text: []string{"Use a builder image to keep the final image small"}, Tags: []string{"build", "containers"}},
+		"tools/git":    types.Note{Title: "Git rebase", Url: "https://git.example/rebase", Created_date: TdCreatedDate, Updated_date: TdUpdatedDate, Text: []string{"Interactive rebase rewrites the history of a branch"}},
+		"languages":    types.Note{Title: "Go builder pattern", Url: "https://go.example/builder", Created_date: TdCreatedDate, Updated_date: TdUpdatedDate, Text: []string{"The builder pattern in go"}, Tags: []string{"build"}},
+	}
+
+	for category, note := range notes {
+		importNote(t, db_manager, db, category, note)
+	}
+
+	return db_manager, db
+}
+
+func search(t *testing.T, db_manager DatabaseManager, db *sql.DB, query string, category string) []SearchResult {
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	results, err := db_manager.SearchNotes(tx, query, category, "", 10)
+
+	failOnError(t, err)
+
+	return results
+}
+
+////
+// Tests
+////
+
+func TestSearchTerm(t *testing.T) {
+	db_manager, db := setupSearch(t)
+
+	results := search(t, db_manager, db, "rebase", "")
+
+	FailNotEquals(t, "Failed to find expected number of notes", 1, len(results))
+	FailNotEquals(t, "Failed to find note", "Git rebase", results[0].Title)
+	FailNotEqualsStruct(t, "Failed to report note categories", []string{"tools/git"}, results[0].Categories)
+	FailNotEquals(t, "Failed to highlight snippet", "Git **rebase**", results[0].Snippet)
+}
+
+func TestSearchPhrase(t *testing.T) {
+	db_manager, db := setupSearch(t)
+
+	FailNotEquals(t, "Failed to find phrase", 1, len(search(t, db_manager, db, `"multi stage"`, "")))
+	FailNotEquals(t, "Failed to match phrase words in order", 0, len(search(t, db_manager, db, `"stage multi"`, "")))
+}
+
+func TestSearchPrefix(t *testing.T) {
+	db_manager, db := setupSearch(t)
+
+	FailNotEquals(t, "Failed to find prefix term", 2, len(search(t, db_manager, db, "build*", "")))
+}
+
+func TestSearchCategory(t *testing.T) {
+	db_manager, db := setupSearch(t)
+
+	results := search(t, db_manager, db, "build*", "tools")
+
+	FailNotEquals(t, "Failed to restrict search to category subtree", 1, len(results))
+	FailNotEquals(t, "Failed to find note in sub-category", "Docker multi stage builds", results[0].Title)
+
+	FailNotEquals(t, "Failed to match category prefix only on full names", 0, len(search(t, db_manager, db, "build*", "tool")))
+}
+
+func TestSearchTag(t *testing.T) {
+	db_manager, db := setupSearch(t)
+
+	tx := beginTransaction(t, db_manager, db)
+	defer tx.Rollback()
+
+	results, err := db_manager.SearchNotes(tx, "build*", "", "containers", 10)
+
+	failOnError(t, err)
+
+	FailNotEquals(t, "Failed to restrict search to tag", 1, len(results))
+	FailNotEqualsStruct(t, "Failed to report note tags", []string{"build", "containers"}, results[0].Tags)
+}
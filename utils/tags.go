@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"database/sql"
+	"slices"
+	"strings"
+)
+
+// Normalise_tags trims the tags and returns them sorted, without duplicates or empty tags
+func Normalise_tags(tags []string) []string {
+	normalised := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			normalised = append(normalised, tag)
+		}
+	}
+
+	slices.Sort(normalised)
+
+	normalised = slices.Compact(normalised)
+
+	if len(normalised) == 0 {
+		return nil
+	}
+
+	return normalised
+}
+
+func (d *DatabaseManager) setNoteTags(tx *sql.Tx, note_id int64, tags []string) error {
+	delete_note_tags_stmt := `delete from note_tags where note_id = $1;`
+
+	if _, err := tx.Exec(delete_note_tags_stmt, note_id); err != nil {
+		return &DatabaseError{delete_note_tags_stmt, "", err}
+	}
+
+	insert_tag_stmt := `insert or ignore into tags (name) values ($1);`
+	insert_note_tag_stmt := `insert into note_tags (note_id, tag_id) select $1, id from tags where name = $2;`
+
+	for _, tag := range Normalise_tags(tags) {
+		if _, err := tx.Exec(insert_tag_stmt, tag); err != nil {
+			return &DatabaseError{insert_tag_stmt, "", err}
+		}
+
+		if _, err := tx.Exec(insert_note_tag_stmt, note_id, tag); err != nil {
+			return &DatabaseError{insert_note_tag_stmt, "", err}
+		}
+	}
+
+	return nil
+}
+
+func (d *DatabaseManager) noteTags(tx *sql.Tx, note_id int64) ([]string, error) {
+	select_note_tags_stmt := `select tags.name from note_tags inner join tags on note_tags.tag_id = tags.id where note_tags.note_id = $1 order by tags.name;`
+
+	rows, err := tx.Query(select_note_tags_stmt, note_id)
+	if err != nil {
+		return nil, &DatabaseError{select_note_tags_stmt, "", err}
+	}
+
+	defer rows.Close()
+
+	var tags []string
+
+	for rows.Next() {
+		var tag string
+
+		if err = rows.Scan(&tag); err != nil {
+			return nil, &DatabaseError{select_note_tags_stmt, "", err}
+		}
+
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &DatabaseError{select_note_tags_stmt, "", err}
+	}
+
+	return tags, nil
+}
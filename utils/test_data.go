@@ -2,90 +2,133 @@ package utils
 
 import (
 	"cotonetes/types"
+	"time"
 )
 
+// Layout used to write the test note dates in latex
+const TdDateLayout = "2006-01-02"
+
+var TdCreatedDate = time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+var TdUpdatedDate = time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC)
+
 var TdTextOnly = TestInput{
 	types.Note{
-		"Sample title",
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{"Sample line"},
+		Title:        "Sample title",
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"Sample line"},
 	},
 	types.Note{
-		"Sample title",
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{"Sample line"},
+		Title:        "Sample title",
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"Sample line"},
 	},
 }
 
 var TdTitleSpecialChars = TestInput{
 	types.Note{
-		`\&\#\%\_\$\^`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{"Sample line"},
+		Title:        `\&\#\%\_\$\^{}`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"Sample line"},
 	},
 	types.Note{
-		`&#%_$^`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{"Sample line"},
+		Title:        `&#%_$^`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"Sample line"},
 	},
 }
 
 var TdNoteBoldText = TestInput{
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{`some text with \textbf{bold content} for test. Real \textbf{bold}`},
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{`some text with \textbf{bold content} for test. Real \textbf{bold}`},
 	},
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{`some text with **bold content** for test. Real **bold**`},
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{`some text with **bold content** for test. Real **bold**`},
+	},
+}
+
+var TdNoteInlineFormatting = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{`some \emph{emphasis with \textbf{bold}}, \textbf{bold with \emph{emphasis}}, \verb|code_name| and \underline{underlined} text`},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"some *emphasis with **bold***, **bold with *emphasis***, `code_name` and <u>underlined</u> text"},
 	},
 }
 
 var TdNoteUrl = TestInput{
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text with \url{link text} for test`,
 			`another line with another \url{link}`,
 		},
 	},
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text with [link text](link text) for test`,
 			`another line with another [link](link)`,
 		},
 	},
 }
 
+var TdNoteHref = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{`see \href{https://example.com/some_page?a=1&b=2\#part}{the \textbf{docs} of\_it} and \url{https://example.com}`},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{`see [the **docs** of_it](https://example.com/some_page?a=1&b=2#part) and [https://example.com](https://example.com)`},
+	},
+}
+
 var TdNoteNewline = TestInput{
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
-			`some text for test\\`,
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`some text for test`,
+			`\\`,
 			``,
 			`another line with another`,
 			`\\`,
@@ -93,11 +136,11 @@ var TdNoteNewline = TestInput{
 		},
 	},
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text for test`,
 			``,
 			`another line with another`,
@@ -108,11 +151,11 @@ var TdNoteNewline = TestInput{
 
 var TdNoteItemize = TestInput{
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text with \url{link text} for test`,
 			`\begin{itemize}`,
 			`\item item 1`,
@@ -122,11 +165,11 @@ var TdNoteItemize = TestInput{
 		},
 	},
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text with [link text](link text) for test`,
 			`* item 1`,
 			`* item 2`,
@@ -137,11 +180,11 @@ var TdNoteItemize = TestInput{
 
 var TdNoteEnumerate = TestInput{
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text with \url{link text} for test`,
 			`\begin{enumerate}`,
 			`\item item 1`,
@@ -151,11 +194,11 @@ var TdNoteEnumerate = TestInput{
 		},
 	},
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text with [link text](link text) for test`,
 			`1. item 1`,
 			`2. item 2`,
@@ -164,13 +207,270 @@ var TdNoteEnumerate = TestInput{
 	},
 }
 
+var TdNoteNestedList = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`Steps to follow`,
+			`\begin{enumerate}`,
+			`\item Install \textbf{git}`,
+			`  \begin{itemize}`,
+			`  \item on Linux, see \href{https://git-scm.com}{the site}`,
+			`  \item on Mac, 100\%`,
+			`  \end{itemize}`,
+			`\item Configure it`,
+			`  \begin{enumerate}`,
+			`  \item name`,
+			`  \item email`,
+			`  \end{enumerate}`,
+			`\end{enumerate}`,
+			``,
+			`Then`,
+			`\begin{itemize}`,
+			`\item see the \url{history}`,
+			`  \begin{enumerate}`,
+			`  \item \verb|git log|`,
+			`  \end{enumerate}`,
+			`\end{itemize}`,
+		},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`Steps to follow`,
+			`1. Install **git**`,
+			`    * on Linux, see [the site](https://git-scm.com)`,
+			`    * on Mac, 100%`,
+			`2. Configure it`,
+			`    1. name`,
+			`    2. email`,
+			``,
+			`Then`,
+			`* see the [history](history)`,
+			"    1. `git log`",
+		},
+	},
+}
+
+var TdNoteMath = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`energy $E = mc^2$ costs \$5, see $a_1 * b_{*}$`,
+			`\[\sum_{i=1}^n i\]`,
+			`\[`,
+			`x^2 + y^2 = z^2`,
+			`\]`,
+		},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`energy $E = mc^2$ costs \$5, see $a_1 * b_{*}$`,
+			`$$\sum_{i=1}^n i$$`,
+			`$$`,
+			`x^2 + y^2 = z^2`,
+			`$$`,
+		},
+	},
+}
+
+var TdNoteTable = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`tools compared`,
+			`\begin{tabular}{|l|c|r|}`,
+			`\hline`,
+			`Tool & \textbf{Version} & Notes \\`,
+			`\hline`,
+			`git & 2.4 & see \href{https://git-scm.com}{the site} \\`,
+			`docker & \verb|27| & 100\% \\`,
+			`\hline`,
+			`\end{tabular}`,
+			`after the table`,
+		},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`tools compared`,
+			`| Tool | **Version** | Notes |`,
+			`| --- | :---: | ---: |`,
+			`| git | 2.4 | see [the site](https://git-scm.com) |`,
+			"| docker | `27` | 100% |",
+			`after the table`,
+		},
+	},
+}
+
+var TdNoteTablePipes = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`\begin{tabular}{|l|l|}`,
+			`\hline`,
+			`Math & Code \\`,
+			`\hline`,
+			`$|x|$ & \verb!a|b! \\`,
+			`\hline`,
+			`\end{tabular}`,
+		},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`| Math | Code |`,
+			`| --- | --- |`,
+			"| $\\|x\\|$ | `a\\|b` |",
+		},
+	},
+}
+
+var TdNoteQuote = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`as the page says`,
+			`\begin{quote}`,
+			`first \textbf{paragraph}`,
+			`second line`,
+			``,
+			`second paragraph`,
+			``,
+			`\begin{quote}`,
+			`nested`,
+			`\end{quote}`,
+			`\end{quote}`,
+			``,
+			`steps`,
+			`\begin{itemize}`,
+			`\item first`,
+			`  \begin{quote}`,
+			`  quoted in item`,
+			`  \end{quote}`,
+			`\item second`,
+			`\end{itemize}`,
+		},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`as the page says`,
+			`> first **paragraph**`,
+			`> second line`,
+			`>`,
+			`> second paragraph`,
+			`>`,
+			`> > nested`,
+			``,
+			`steps`,
+			`* first`,
+			`    > quoted in item`,
+			`* second`,
+		},
+	},
+}
+
+var TdNoteImage = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`click the \includegraphics{images/button_icon.png} button`,
+			`\begin{figure}[h]`,
+			`  \centering`,
+			`  \includegraphics[width=\linewidth]{images/build.png}`,
+			`  \caption{the \textbf{build} output}`,
+			`\end{figure}`,
+			`after the figure`,
+		},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`click the ![](images/button_icon.png) button`,
+			`![the **build** output](images/build.png)`,
+			`after the figure`,
+		},
+		Images:       map[string][]byte{
+			"images/button_icon.png": []byte("button icon"),
+			"images/build.png":       []byte("build output"),
+		},
+	},
+}
+
+var TdNoteFootnote = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`a side remark\footnote{see the \textbf{docs}, 100\%} and another\footnote{$x^2$ is squared}.`,
+			`\begin{itemize}`,
+			`\item in a list\footnote{third}`,
+			`\end{itemize}`,
+		},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`a side remark[^1] and another[^2].`,
+			`* in a list[^3]`,
+			``,
+			`[^1]: see the **docs**, 100%`,
+			`[^2]: $x^2$ is squared`,
+			`[^3]: third`,
+		},
+	},
+}
+
 var TdNoteVerbatim = TestInput{
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text with \url{link text} for test`,
 			`\begin{verbatim}`,
 			``,
@@ -184,11 +484,11 @@ var TdNoteVerbatim = TestInput{
 		},
 	},
 	types.Note{
-		`Sample title`,
-		"Sample url",
-		"Sample create date",
-		"Sample update date",
-		[]string{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
 			`some text with [link text](link text) for test`,
 			"```",
 			``,
@@ -202,3 +502,108 @@ var TdNoteVerbatim = TestInput{
 		},
 	},
 }
+
+var TdNoteCode = TestInput{
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`build it with`,
+			`\begin{minted}{go}`,
+			`func main() {`,
+			"\tfmt.Println(`100%` + \"{}\")",
+			`}`,
+			`\end{minted}`,
+			`then run \verb|go run .| or \verb!a|b!, as \textbf{\texttt{bold\_code}}`,
+			`\begin{lstlisting}[language=Python]`,
+			`print("~x")`,
+			`\end{lstlisting}`,
+		},
+	},
+	types.Note{
+		Title:        `Sample title`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			`build it with`,
+			"```go",
+			`func main() {`,
+			"\tfmt.Println(`100%` + \"{}\")",
+			`}`,
+			"```",
+			"then run `go run .` or `a|b`, as **`bold_code`**",
+			"```python",
+			`print("~x")`,
+			"```",
+		},
+	},
+}
+
+// accented letters and typographic characters, the latex being written as the ascii export writes them
+var TdNoteAccents = TestInput{
+	types.Note{
+		Title:        `Caf\'e -- pre\c{c}o`,
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			"\\emph{Acentua\\c{c}\\~ao} em portugu\\^es: ``cora\\c{c}\\~ao'' --- n\\~ao \\'e f\\'acil\\ldots{}",
+			`p\'aginas 10--20, stra\ss{}e, na\"ive~text, $a--b$ and \verb|--x|`,
+		},
+		Tags:         []string{`a\c{c}\~ao`},
+	},
+	types.Note{
+		Title:        "Café – preço",
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{
+			"*Acentuação* em português: “coração” — não é fácil…",
+			"páginas 10–20, straße, naïve\u00a0text, $a--b$ and `--x`",
+		},
+		Tags:         []string{"ação"},
+	},
+}
+
+var TdNoteMetadata = TestInput{
+	types.Note{
+		Title:        "Sample title",
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"Sample line"},
+		Tags:         []string{"git"},
+		Metadata:     map[string]string{"Mood": `100\% sure`, "Rating": "4/5", "Author": "Ana Silva", "Source": `\url{https://example.com/a_b}`},
+	},
+	types.Note{
+		Title:        "Sample title",
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"Sample line"},
+		Tags:         []string{"git"},
+		Metadata:     map[string]string{"Mood": "100% sure", "Rating": "4/5", "Author": "Ana Silva", "Source": "https://example.com/a_b"},
+	},
+}
+
+var TdNoteTags = TestInput{
+	types.Note{
+		Title:        "Sample title",
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"Sample line"},
+		Tags:         []string{"git", ` c\_sharp `, "docker", "git"},
+	},
+	types.Note{
+		Title:        "Sample title",
+		Url:          "Sample url",
+		Created_date: TdCreatedDate,
+		Updated_date: TdUpdatedDate,
+		Text:         []string{"Sample line"},
+		Tags:         []string{"c_sharp", "docker", "git"},
+	},
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"cotonetes/types"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -16,8 +17,17 @@ func NoteToLatex(note types.Note) []string {
 
 	latex = append(latex, `\textbf{Title:} ` + note.Title + `\\`)
 	latex = append(latex, `\textbf{URL:} \url{` + note.Url + `}\\`)
-	latex = append(latex, `\textbf{Created:} ` + note.Created_date + `\\`)
-	latex = append(latex, `\textbf{Last Updated:} ` + note.Updated_date + `\\`)
+	latex = append(latex, `\textbf{Created:} ` + note.Created_date.Format(TdDateLayout) + `\\`)
+	latex = append(latex, `\textbf{Last Updated:} ` + note.Updated_date.Format(TdDateLayout) + `\\`)
+
+	if len(note.Tags) > 0 {
+		latex = append(latex, `\textbf{Tags:} ` + strings.Join(note.Tags, ", ") + `\\`)
+	}
+
+	for _, key := range Metadata_order(note.Metadata) {
+		latex = append(latex, `\textbf{` + key + `:} ` + note.Metadata[key] + `\\`)
+	}
+
 	latex = append(latex, `\\`)
 
 	for _, line := range note.Text {
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// query parameters that only track where a visitor came from, besides the utm_* ones
+var tracking_parameters = map[string]bool{"fbclid": true, "gclid": true, "mc_cid": true, "mc_eid": true}
+
+// Canonical_url returns the form of the url used to find notes saved from the same page: https scheme, lower case
+// host without "www." or default port, no trailing slash, fragment or tracking parameters, and the remaining
+// parameters sorted. Values that are not absolute urls are only trimmed
+func Canonical_url(raw_url string) string {
+	raw_url = strings.TrimSpace(raw_url)
+
+	u, err := url.Parse(raw_url)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return raw_url
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if scheme == "http" {
+		scheme = "https"
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+
+	query := u.Query()
+
+	for parameter := range query {
+		if strings.HasPrefix(strings.ToLower(parameter), "utm_") || tracking_parameters[strings.ToLower(parameter)] {
+			query.Del(parameter)
+		}
+	}
+
+	canonical := scheme + "://" + host + strings.TrimRight(u.EscapedPath(), "/")
+
+	// Encode sorts the parameters by name
+	if encoded_query := query.Encode(); encoded_query != "" {
+		canonical += "?" + encoded_query
+	}
+
+	return canonical
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestCanonicalUrl(t *testing.T) {
+	expected := "https://example.com/articles/git"
+
+	for _, value := range []string{
+		"https://example.com/articles/git",
+		"http://example.com/articles/git",
+		"https://www.example.com/articles/git",
+		"https://EXAMPLE.com/articles/git/",
+		"https://example.com:443/articles/git",
+		"https://example.com/articles/git#section",
+		"https://example.com/articles/git?utm_source=feed&utm_medium=rss",
+		" https://example.com/articles/git?fbclid=abc ",
+	} {
+		FailNotEquals(t, "Failed to canonicalise url "+value, expected, Canonical_url(value))
+	}
+}
+
+func TestCanonicalUrlQuery(t *testing.T) {
+	FailNotEquals(t, "Failed to sort query parameters", "https://example.com/search?a=1&q=git", Canonical_url("https://example.com/search?q=git&utm_campaign=x&a=1"))
+	FailNotEquals(t, "Failed to keep non default port", "https://example.com:8080", Canonical_url("http://example.com:8080/"))
+}
+
+func TestCanonicalUrlNotAbsolute(t *testing.T) {
+	FailNotEquals(t, "Failed to keep value that is not an absolute url", "Sample url", Canonical_url(" Sample url "))
+}